@@ -5,7 +5,7 @@ See: http://www.eurocontrol.int/sites/default/files/publication/files/20111001-a
 Architecture:
 	lexer provides the definition of a ADEXP lexer (DONE)
 	parser provides the definition of a ADEXP parser (WIP)
-	serialiser provides the definition of an ADEXP serialiser (TODO)
+	Encoder provides an ADEXP serialiser (DONE)
 	this package wraps it all together so as to provide easy & simple unmarshalling of ADEXP documents (WIP)
 */
 package adexp
@@ -21,6 +21,20 @@ const (
 	List
 )
 
+// String implements Stringer
+func (k Kind) String() string {
+	switch k {
+	case Primary:
+		return "primary field"
+	case Structured:
+		return "structured field"
+	case List:
+		return "list field"
+	default:
+		return "unknown kind"
+	}
+}
+
 // value is the conterpart of a keyword
 type value struct {
 	kind  Kind
@@ -28,4 +42,5 @@ type value struct {
 }
 
 // ADEXP is a Message in a ADEXP (Air traffic services Data EXchange Presentation) format.
+// As a map, it keeps neither the order of the fields nor the repeated ones, use a Document for that.
 type ADEXP map[string]value
@@ -0,0 +1,171 @@
+/*
+Package catalog provides the keywords, auxiliary terms and message titles defined by the ADEXP v3.1 specification.
+
+The tables are generated from the tabula extracts in adexp/docs/tables, run "go generate" after updating them.
+*/
+package catalog
+
+import "sort"
+
+//go:generate go run gen.go
+
+// A Class indicates where a keyword can be used
+type Class uint8
+
+// These are the classes of keywords
+const (
+	PrimaryField Class = iota // A primary field is found at the top level of a message
+	Subfield                  // A subfield is found inside a compound field
+)
+
+// String implements Stringer
+func (c Class) String() string {
+	switch c {
+	case PrimaryField:
+		return "primary field"
+	case Subfield:
+		return "subfield"
+	default:
+		return "unknown class"
+	}
+}
+
+// A Kind indicates whether a field holds a value or other fields
+type Kind uint8
+
+// These are the kinds of fields
+const (
+	Basic    Kind = iota // A basic field holds a value
+	Compound             // A compound field holds subfields, or a list of fields
+)
+
+// String implements Stringer
+func (k Kind) String() string {
+	switch k {
+	case Basic:
+		return "basic"
+	case Compound:
+		return "compound"
+	default:
+		return "unknown kind"
+	}
+}
+
+// A Field is a keyword defined by the specification
+type Field struct {
+	Keyword  string
+	Class    Class
+	Kind     Kind
+	List     bool     // List is true for compound fields written as "-BEGIN KEYWORD ... -END KEYWORD"
+	Syntax   string   // Syntax is the formal definition of the field
+	Semantic string   // Semantic is the description of the field
+	Children []string // Children are the keywords allowed in a compound field
+	Parents  []string // Parents are the keywords of the compound fields in which this one is allowed
+}
+
+// Structured returns true if the field is a compound field that isn't a list
+func (f *Field) Structured() bool {
+	return f.Kind == Compound && !f.List
+}
+
+// An AuxiliaryTerm is a named syntax element used in the definition of fields
+type AuxiliaryTerm struct {
+	Name     string
+	Syntax   string
+	Semantic string
+	Fields   []string // Fields are the keywords of the fields using that term
+	Terms    []string // Terms are the names of the auxiliary terms using that term
+}
+
+// A Title is a message title, as used in the TITLE primary field
+type Title struct {
+	Title      string
+	Definition string
+}
+
+// Lookup returns the field associated with the keyword
+func Lookup(keyword string) (*Field, bool) {
+	f, ok := fields[keyword]
+	return f, ok
+}
+
+// IsPrimary returns true if the keyword is a primary field
+func IsPrimary(keyword string) bool {
+	f, ok := fields[keyword]
+	return ok && f.Class == PrimaryField
+}
+
+// IsStructured returns true if the keyword is a compound field that isn't a list
+func IsStructured(keyword string) bool {
+	f, ok := fields[keyword]
+	return ok && f.Structured()
+}
+
+// Allowed returns true if keyword is allowed inside the compound field parent
+func Allowed(parent string, keyword string) bool {
+	_, ok := children[parent][keyword]
+	return ok
+}
+
+// Fields returns all the fields, sorted by keyword
+func Fields() []*Field {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list := make([]*Field, len(keys))
+	for i, k := range keys {
+		list[i] = fields[k]
+	}
+	return list
+}
+
+// LookupTerm returns the auxiliary term associated with the name
+func LookupTerm(name string) (*AuxiliaryTerm, bool) {
+	t, ok := terms[name]
+	return t, ok
+}
+
+// LookupTitle returns the message title
+func LookupTitle(title string) (*Title, bool) {
+	t, ok := titles[title]
+	return t, ok
+}
+
+// Titles returns all the message titles, sorted
+func Titles() []*Title {
+	keys := make([]string, 0, len(titles))
+	for k := range titles {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	list := make([]*Title, len(keys))
+	for i, k := range keys {
+		list[i] = titles[k]
+	}
+	return list
+}
+
+// LookupIndicator returns the keyword of the field equivalent to an ICAO field 18 indicator, such as EOBD for DOF.
+//
+// The field may be a compound one, as for DEP, DEST & ALTN. The estimated elapsed times of EET are given by EETPT, or EETFIR for a FIR.
+func LookupIndicator(indicator string) (keyword string, ok bool) {
+	keyword, ok = indicators[indicator]
+	return keyword, ok
+}
+
+// children indexes the children of compound fields, for quick lookups
+var children = func() map[string]map[string]struct{} {
+	index := make(map[string]map[string]struct{})
+	for keyword, f := range fields {
+		if len(f.Children) == 0 {
+			continue
+		}
+		index[keyword] = make(map[string]struct{}, len(f.Children))
+		for _, child := range f.Children {
+			index[keyword][child] = struct{}{}
+		}
+	}
+	return index
+}()
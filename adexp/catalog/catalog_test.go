@@ -0,0 +1,143 @@
+package catalog
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	f, ok := Lookup("ARCID")
+	if !ok {
+		t.Fatalf("ARCID not found")
+	}
+	if f.Class != PrimaryField || f.Kind != Basic {
+		t.Errorf("unexpected ARCID: %s %s", f.Class, f.Kind)
+	}
+	if !IsPrimary("ARCID") {
+		t.Errorf("ARCID should be a primary field")
+	}
+	if IsPrimary("GEOID") {
+		t.Errorf("GEOID shouldn't be a primary field")
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	for _, tc := range []struct {
+		parent  string
+		keyword string
+		allowed bool
+	}{
+		{"GEO", "GEOID", true},
+		{"GEO", "LATTD", true},
+		{"GEO", "ARCID", false},
+		{"ADDR", "FAC", true},
+		{"RTEPTS", "PT", true},
+		{"PT", "PTID", true},
+		{"ATNLOGON", "CPCQVLTSP", true},
+		{"ARCID", "GEOID", false},
+	} {
+		if got := Allowed(tc.parent, tc.keyword); got != tc.allowed {
+			t.Errorf("Allowed(%s, %s): got %t, expected %t", tc.parent, tc.keyword, got, tc.allowed)
+		}
+	}
+
+	if !IsStructured("GEO") || IsStructured("ADDR") || IsStructured("ARCID") {
+		t.Errorf("unexpected IsStructured results")
+	}
+	if f, _ := Lookup("ADDR"); !f.List {
+		t.Errorf("ADDR should be a list")
+	}
+}
+
+func TestTables(t *testing.T) {
+	for _, f := range Fields() {
+		for _, child := range f.Children {
+			if _, ok := Lookup(child); !ok {
+				t.Errorf("%s: child %s isn't in the catalog", f.Keyword, child)
+			}
+		}
+	}
+
+	if term, ok := LookupTerm("aircraftid"); !ok || term.Syntax != "2{ ALPHANUM }7" {
+		t.Errorf("unexpected aircraftid term: %v", term)
+	}
+	if title, ok := LookupTitle("ACK"); !ok || title.Definition != "Acknowledge Message" {
+		t.Errorf("unexpected ACK title: %v", title)
+	}
+}
+
+func TestRules(t *testing.T) {
+	for title, r := range rules {
+		if _, ok := LookupTitle(title); !ok {
+			t.Errorf("rule for unknown title %s", title)
+		}
+		for _, k := range join(r.Mandatory, r.Optional) {
+			if !IsPrimary(k) {
+				t.Errorf("%s: %s isn't a primary field", title, k)
+			}
+		}
+	}
+
+	for _, title := range Titles() {
+		if _, ok := LookupRule(title.Title); !ok {
+			t.Errorf("no rule for title %s", title.Title)
+		}
+	}
+	if r, _ := LookupRule("ABI"); !r.Open || !r.Allows("ROUTE") || len(r.Mandatory) != 0 {
+		t.Errorf("unexpected ABI rule: %v", r)
+	}
+
+	r, ok := LookupRule("SAM")
+	if !ok {
+		t.Fatalf("SAM rule not found")
+	}
+	if !r.Allows("TITLE") || !r.Allows("CTOT") || !r.Allows("ADDR") || !r.Allows("GEO") || r.Allows("ROUTE") {
+		t.Errorf("unexpected SAM rule: %v", r)
+	}
+}
+
+func TestLookupRule_ICAO(t *testing.T) {
+	// The IFPL conveys every field of an ICAO FPL
+	ifpl, _ := LookupRule("IFPL")
+	for _, k := range []string{"SSRCODE", "WKTRC", "CEQPT", "ALTRNT1", "SPLE", "SPLDNB"} {
+		if !ifpl.Allows(k) {
+			t.Errorf("IFPL: %s not allowed", k)
+		}
+	}
+	for indicator, k := range indicators {
+		if !ifpl.Allows(k) {
+			t.Errorf("IFPL: %s, the equivalent of indicator %s, not allowed", k, indicator)
+		}
+	}
+
+	// The ICHG keeps the previous identification of the flight
+	ichg, _ := LookupRule("ICHG")
+	if !ichg.Allows("ARCIDOLD") || !ichg.Allows("EOBDOLD") {
+		t.Errorf("ICHG: previous identification not allowed")
+	}
+
+	// An ARR only gives the destination after a diversion
+	iarr, _ := LookupRule("IARR")
+	for _, k := range iarr.Mandatory {
+		if k == "ADES" {
+			t.Errorf("IARR: ADES is mandatory")
+		}
+	}
+	if !iarr.Allows("ADES") {
+		t.Errorf("IARR: ADES not allowed")
+	}
+}
+
+func TestLookupIndicator(t *testing.T) {
+	for indicator, keyword := range indicators {
+		if _, ok := Lookup(keyword); !ok {
+			t.Errorf("indicator %s: unknown keyword %s", indicator, keyword)
+		}
+	}
+
+	for indicator, expected := range map[string]string{"PBN": "PBN", "DOF": "EOBD", "REG": "REG", "TYP": "TYPZ"} {
+		if keyword, ok := LookupIndicator(indicator); !ok || keyword != expected {
+			t.Errorf("indicator %s: got %q, expected %q", indicator, keyword, expected)
+		}
+	}
+	if _, ok := LookupIndicator("XYZ"); ok {
+		t.Errorf("unknown indicator XYZ found")
+	}
+}
@@ -0,0 +1,474 @@
+//go:build ignore
+// +build ignore
+
+// gen generates tables.go from the tabula extracts of the ADEXP v3.1 specification tables.
+// The extracts are not perfectly clean: cells span several lines, names are sometimes cut in two and some entries are missing.
+// This program cleans what it can and adds the missing entries from the supplements below.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const tablesDir = "../docs/tables"
+
+// field is a field as extracted from the tables
+type field struct {
+	name     string // name is the lower-case name used in the tables
+	keyword  string
+	subfield bool
+	compound bool
+	list     bool
+	syntax   string
+	semantic string
+	children map[string]bool
+	parents  map[string]bool
+}
+
+// term is an auxiliary term as extracted from the tables
+type term struct {
+	name     string
+	syntax   string
+	semantic string
+	fields   []string
+	terms    []string
+}
+
+// supplements are the entries that are missing from the extracts, but present in the specification
+var supplements = []field{
+	{
+		name: "pt", subfield: true, compound: true,
+		syntax:   `'-' "PT" ptid [(fl | flblock)] [eto] [to] [cto] [sto] [sfl] [ptstay] [ptrfl] [ptrulchg] [(ptspeed | ptmach)] [ptrte] [ptcrsclimb]`,
+		semantic: "A point of the route of a flight, with its associated information.",
+	},
+	{
+		name: "refid", subfield: true,
+		syntax:   `'-' "REFID" refname`,
+		semantic: "Identifier of a reference point.",
+	},
+	{
+		name:     "altrnt1",
+		syntax:   `'-' "ALTRNT1" (icaoaerodrome | 'ZZZZ')`,
+		semantic: "First alternate aerodrome, as ICAO Field 16c.",
+	},
+	{
+		name:     "ceqpt",
+		syntax:   `'-' "CEQPT" aidequipment`,
+		semantic: "Radiocommunication, navigation and approach aid equipment and capabilities, as ICAO Field 10a.",
+	},
+	{
+		name:     "spldcol",
+		syntax:   `'-' "SPLDCOL" 1{ LIM_CHAR }50`,
+		semantic: "Dinghies: colour, as ICAO Field 19 element 'D/'.",
+	},
+	{
+		name:     "wktrc",
+		syntax:   `'-' "WKTRC" waketurbcat`,
+		semantic: "Wake turbulence category of the aircraft, as ICAO Field 9c.",
+	},
+	{
+		name: "crfl1", subfield: true,
+		syntax:   `'-' "CRFL1" flightlevel`,
+		semantic: "The lower limit of the flight level band within which a cruise climb is requested.",
+	},
+}
+
+// termSupplements are the auxiliary terms that are missing from the extracts, but present in the specification
+var termSupplements = []term{
+	{
+		name:     "atsroute",
+		syntax:   `2{ALPHANUM}7`,
+		semantic: "Identifier of an ATS route.",
+	},
+	{
+		name:     "equipmentcode",
+		syntax:   `1{ ALPHANUM }`,
+		semantic: "The ICAO designators of the radiocommunication, navigation and approach aid equipment and capabilities.",
+	},
+	{
+		name:     "flightlevel",
+		syntax:   `('F' ! 3{DIGIT}3) | ('A' ! 3{DIGIT}3) | ('S' ! 4{DIGIT}4) | ('M' ! 4{DIGIT}4)`,
+		semantic: "A flight level or altitude, in hundreds of feet (F), tens of metres (S), or as an altitude in hundreds of feet (A) or tens of metres (M).",
+	},
+	{
+		name:     "hexadecimal",
+		syntax:   `DIGIT | 'A' | 'B' | 'C' | 'D' | 'E' | 'F'`,
+		semantic: "A hexadecimal digit.",
+	},
+	{
+		name:     "spd",
+		syntax:   `('N' ! 4{DIGIT}4) | ('M' ! 3{DIGIT}3) | ('K' ! 4{DIGIT}4)`,
+		semantic: "A speed, as a true air speed in knots (N) or kilometres per hour (K), or as a Mach number in hundredths (M).",
+	},
+	{
+		name:     "timemmss_elapsed",
+		syntax:   `4{DIGIT}4`,
+		semantic: "An elapsed time, in minutes and seconds (MMSS).",
+	},
+}
+
+// extraChildren are the relationships that can't be extracted, as the names are glued together in the extracts
+var extraChildren = map[string][]string{
+	"atnlogon":   {"cmltsp", "adsqvltsp", "cpcqvltsp", "atiqv"},
+	"crsclimb":   {"crfl1"},
+	"ptcrsclimb": {"crfl1"},
+	"ref":        {"refid"},
+	"altnz":      {"refid"},
+	"depz":       {"refid"},
+	"destz":      {"refid"},
+}
+
+// extraIndicators are the ICAO field 18 indicators whose equivalent field isn't given by the semantics
+var extraIndicators = map[string]string{
+	"ALTN": "altnz",
+	"CODE": "arcaddr",
+	"DAT":  "dat",
+	"DEP":  "depz",
+	"DEST": "destz",
+	"DOF":  "eobd",
+	"EET":  "eetpt",
+	"EUR":  "eur",
+	"IFP":  "ifp",
+	"ORGN": "orgn",
+	"RFP":  "rfp",
+	"RIF":  "rif",
+	"TYP":  "typz",
+}
+
+// indicatorRegexp finds the field 18 indicator in the semantics of a field, such as "ICAO field 18 PBN/"
+var indicatorRegexp = regexp.MustCompile(`(?i:fi?e?ld)\s*18\s*(?i:element)?\s*'?([A-Z]{2,4})/`)
+
+var (
+	quotesReplacer = strings.NewReplacer("‘", "'", "’", "'", "“", `"`, "”", `"`)
+	quotedRegexp   = regexp.MustCompile(`"[^"]*"|'[^'\s]*'`)
+	identRegexp    = regexp.MustCompile(`[a-z][a-z0-9]*`)
+	keywordRegexp  = regexp.MustCompile(`"\s*([A-Z][A-Z0-9]*)\s*"`)
+)
+
+func main() {
+	fields := make(map[string]*field)
+
+	// Primary fields
+	for _, r := range readTable("tabula-primary-fields.csv", 4, "ADEXP Primary Field") {
+		f := &field{
+			name:     cleanName(r[0]),
+			compound: strings.TrimSpace(r[1]) == "c",
+			syntax:   cleanText(r[2]),
+			semantic: cleanText(r[3]),
+		}
+		fields[f.name] = f
+	}
+
+	// Subfields, the first column is empty
+	var usedIn = make(map[string][]string)
+	for _, r := range readTable("tabula-subfields.csv", 7, "Subfield") {
+		f := &field{
+			name:     cleanName(r[1]),
+			subfield: true,
+			compound: strings.TrimSpace(r[2]) == "c",
+			syntax:   cleanText(r[3]),
+			semantic: cleanText(r[4]),
+		}
+		fields[f.name] = f
+		usedIn[f.name] = append(splitNames(r[5]), splitNames(r[6])...)
+	}
+
+	// Supplements
+	for i := range supplements {
+		f := supplements[i]
+		if _, ok := fields[f.name]; !ok {
+			fields[f.name] = &f
+		}
+	}
+
+	// Keywords & relationships
+	for _, f := range fields {
+		f.keyword = keywordOf(f, fields)
+		f.list = f.compound && strings.Contains(f.syntax, `"BEGIN"`)
+		f.children = make(map[string]bool)
+		f.parents = make(map[string]bool)
+	}
+	for _, f := range fields {
+		if !f.compound {
+			continue
+		}
+		for _, ident := range identRegexp.FindAllString(quotedRegexp.ReplaceAllString(f.syntax, ""), -1) {
+			if _, ok := fields[ident]; ok && ident != f.name {
+				f.children[ident] = true
+			}
+		}
+	}
+	for child, parents := range usedIn {
+		for _, parent := range parents {
+			if p, ok := fields[parent]; ok && p.compound {
+				p.children[child] = true
+			}
+		}
+	}
+	for parent, list := range extraChildren {
+		for _, child := range list {
+			fields[parent].children[child] = true
+		}
+	}
+	for _, f := range fields {
+		for child := range f.children {
+			fields[child].parents[f.name] = true
+		}
+	}
+
+	// Auxiliary terms, the first column is empty
+	var terms []*term
+	termNames := make(map[string]bool)
+	auxRows := readTable("tabula-auxiliary-fields.csv", 7, "Auxiliary Term")
+	for _, r := range auxRows {
+		termNames[cleanName(r[1])] = true
+	}
+	for _, r := range auxRows {
+		t := &term{
+			name:     cleanName(r[1]),
+			syntax:   cleanText(r[2]),
+			semantic: cleanText(r[3]),
+		}
+		for _, name := range append(splitNames(r[4]), splitNames(r[5])...) {
+			if f, ok := fields[name]; ok {
+				t.fields = append(t.fields, f.keyword)
+			}
+		}
+		for _, name := range splitNames(r[6]) {
+			if termNames[name] {
+				t.terms = append(t.terms, name)
+			}
+		}
+		terms = append(terms, t)
+	}
+	for i := range termSupplements {
+		t := termSupplements[i]
+		if !termNames[t.name] {
+			terms = append(terms, &t)
+		}
+	}
+
+	// Titles
+	var titles [][2]string
+	for _, r := range readTable("tabula-message-titles.csv", 2, "Title") {
+		titles = append(titles, [2]string{strings.TrimSpace(r[0]), cleanText(r[1])})
+	}
+
+	// ICAO field 18 indicators
+	indicators := make(map[string]string)
+	for _, f := range fields {
+		if m := indicatorRegexp.FindStringSubmatch(f.semantic); m != nil && !f.subfield {
+			indicators[m[1]] = f.keyword
+		}
+	}
+	for indicator, name := range extraIndicators {
+		indicators[indicator] = fields[name].keyword
+	}
+
+	// Write
+	out := &bytes.Buffer{}
+	fmt.Fprint(out, "// Code generated by gen.go; DO NOT EDIT.\n\npackage catalog\n\n")
+	writeFields(out, fields)
+	writeTerms(out, terms)
+	writeTitles(out, titles)
+	writeIndicators(out, indicators)
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("error while formatting generated code: %v", err)
+	}
+	if err := ioutil.WriteFile("tables.go", src, 0644); err != nil {
+		log.Fatalf("error while writing generated code: %v", err)
+	}
+}
+
+// readTable reads a table, skipping the repeated header rows identified by their header
+func readTable(name string, columns int, header string) [][]string {
+	file, err := os.Open(filepath.Join(tablesDir, name))
+	if err != nil {
+		log.Fatalf("error while opening %s: %v", name, err)
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		log.Fatalf("error while reading %s: %v", name, err)
+	}
+
+	var rows [][]string
+	for i, rec := range records {
+		if len(rec) < columns {
+			log.Fatalf("%s: record %d has %d columns instead of %d", name, i, len(rec), columns)
+		}
+		isHeader := false
+		for _, cell := range rec {
+			if strings.TrimSpace(cell) == header {
+				isHeader = true
+			}
+		}
+		if !isHeader {
+			rows = append(rows, rec)
+		}
+	}
+	return rows
+}
+
+// cleanName returns a name without the spaces & line breaks introduced by the extraction
+func cleanName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
+
+// cleanText returns a text on one line, with straight quotes
+func cleanText(text string) string {
+	return quotesReplacer.Replace(strings.Join(strings.Fields(text), " "))
+}
+
+// splitNames splits a list of names, gluing back those cut in two by the extraction
+func splitNames(cell string) []string {
+	tokens := strings.FieldsFunc(cell, func(r rune) bool {
+		return r == '\n' || r == ',' || r == ' '
+	})
+	var names []string
+	for i := 0; i < len(tokens); i++ {
+		name := strings.ToLower(strings.TrimRight(tokens[i], "."))
+		if i+1 < len(tokens) && !knownName(name) && knownName(name+tokens[i+1]) {
+			name += tokens[i+1]
+			i++
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// knownNames holds the names of all the fields & terms, it is filled on first use and used to glue back cut names
+var knownNames = make(map[string]bool)
+
+func knownName(name string) bool {
+	if len(knownNames) == 0 {
+		for _, table := range []struct {
+			file    string
+			columns int
+			header  string
+			column  int
+		}{
+			{"tabula-primary-fields.csv", 4, "ADEXP Primary Field", 0},
+			{"tabula-subfields.csv", 7, "Subfield", 1},
+			{"tabula-auxiliary-fields.csv", 7, "Auxiliary Term", 1},
+		} {
+			for _, r := range readTable(table.file, table.columns, table.header) {
+				knownNames[cleanName(r[table.column])] = true
+			}
+		}
+		for _, f := range supplements {
+			knownNames[f.name] = true
+		}
+	}
+	return knownNames[name]
+}
+
+// keywordOf returns the keyword of a field, as written in its syntax.
+// If that keyword is the name of another field, the syntax is wrong and the name of the field is used instead.
+func keywordOf(f *field, fields map[string]*field) string {
+	for _, m := range keywordRegexp.FindAllStringSubmatch(f.syntax, -1) {
+		if m[1] == "BEGIN" || m[1] == "END" {
+			continue
+		}
+		if other, ok := fields[strings.ToLower(m[1])]; ok && other != f {
+			break
+		}
+		return m[1]
+	}
+	return strings.ToUpper(f.name)
+}
+
+func writeFields(out *bytes.Buffer, fields map[string]*field) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprint(out, "var fields = map[string]*Field{\n")
+	for _, name := range names {
+		f := fields[name]
+		class, kind := "PrimaryField", "Basic"
+		if f.subfield {
+			class = "Subfield"
+		}
+		if f.compound {
+			kind = "Compound"
+		}
+		fmt.Fprintf(out, "%q: {\nKeyword: %q,\nClass: %s,\nKind: %s,\n", f.keyword, f.keyword, class, kind)
+		if f.list {
+			fmt.Fprint(out, "List: true,\n")
+		}
+		fmt.Fprintf(out, "Syntax: %q,\nSemantic: %q,\n", f.syntax, f.semantic)
+		if len(f.children) != 0 {
+			fmt.Fprintf(out, "Children: %#v,\n", keywords(fields, f.children))
+		}
+		if len(f.parents) != 0 {
+			fmt.Fprintf(out, "Parents: %#v,\n", keywords(fields, f.parents))
+		}
+		fmt.Fprint(out, "},\n")
+	}
+	fmt.Fprint(out, "}\n\n")
+}
+
+// keywords returns the sorted keywords of the given set of names
+func keywords(fields map[string]*field, set map[string]bool) []string {
+	list := make([]string, 0, len(set))
+	for name := range set {
+		list = append(list, fields[name].keyword)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func writeTerms(out *bytes.Buffer, terms []*term) {
+	sort.Slice(terms, func(i, j int) bool { return terms[i].name < terms[j].name })
+	fmt.Fprint(out, "var terms = map[string]*AuxiliaryTerm{\n")
+	for _, t := range terms {
+		fmt.Fprintf(out, "%q: {\nName: %q,\nSyntax: %q,\nSemantic: %q,\n", t.name, t.name, t.syntax, t.semantic)
+		if len(t.fields) != 0 {
+			fmt.Fprintf(out, "Fields: %#v,\n", t.fields)
+		}
+		if len(t.terms) != 0 {
+			fmt.Fprintf(out, "Terms: %#v,\n", t.terms)
+		}
+		fmt.Fprint(out, "},\n")
+	}
+	fmt.Fprint(out, "}\n\n")
+}
+
+func writeTitles(out *bytes.Buffer, titles [][2]string) {
+	fmt.Fprint(out, "var titles = map[string]*Title{\n")
+	for _, t := range titles {
+		fmt.Fprintf(out, "%q: {Title: %q, Definition: %q},\n", t[0], t[0], t[1])
+	}
+	fmt.Fprint(out, "}\n\n")
+}
+
+func writeIndicators(out *bytes.Buffer, indicators map[string]string) {
+	names := make([]string, 0, len(indicators))
+	for name := range indicators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprint(out, "var indicators = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(out, "%q: %q,\n", name, indicators[name])
+	}
+	fmt.Fprint(out, "}\n")
+}
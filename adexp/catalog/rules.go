@@ -0,0 +1,121 @@
+package catalog
+
+// A Rule lists the primary fields of the messages of a given title.
+//
+// The TITLE field is always mandatory, and isn't listed.
+type Rule struct {
+	Title     string
+	Mandatory []string // Mandatory are the keywords that must be present
+	Optional  []string // Optional are the other keywords that may be present
+	Open      bool     // Open is true if any field may be present, besides the listed ones
+}
+
+// Allows returns true if the keyword may be present in a message of the rule's title
+func (r *Rule) Allows(keyword string) bool {
+	_, ok := allowed[r.Title][keyword]
+	return ok || r.Open || keyword == "TITLE"
+}
+
+// LookupRule returns the rule of the given message title.
+//
+// Every title of the catalog has a rule. As the specification leaves the content of the messages to the documents of the systems using them,
+// only the rules of the IFPS and ETFMS messages list their fields, following these documents; the rules of the other titles are open.
+func LookupRule(title string) (*Rule, bool) {
+	r, ok := rules[title]
+	return r, ok
+}
+
+// These are groups of fields shared by several rules
+var (
+	// header are the fields found in the header of the IFPS & ETFMS messages
+	header = []string{"ADDR", "FILTIM", "ORIGIN", "ORIGINDT", "MSGREF", "COMMENT"}
+
+	// flight identifies a flight
+	flight = []string{"IFPLID", "ARCID", "ADEP", "ADES", "EOBD", "EOBT"}
+
+	// flightPlan are the fields describing a flight plan, outside of its identification.
+	// They include the ADEXP equivalents of every field of the ICAO FPL (Doc 4444, appendix 2), as the IFPL conveys the whole flight plan:
+	// SSRCODE for field 7, WKTRC for field 9, CEQPT for field 10, ALTRNT1 for field 16, the field 18 indicators (see LookupIndicator) and the SPL fields for field 19.
+	flightPlan = []string{
+		"SSRCODE", "ARCTYP", "FLTRUL", "FLTTYP", "NBARC", "WKTRC", "CEQPT", "SEQPT", "EQCST", "ROUTE", "RFL", "SPEED", "TTLEET", "EETFIR", "EETPT", "RTEPTS",
+		"SID", "STAR", "ALTRNT1", "ALTRNT2", "DEPZ", "DESTZ", "ALTNZ", "PBN", "REG", "RMK", "STS", "OPR", "PER", "SEL", "TYPZ", "RALT", "TALT",
+		"COM", "NAV", "DAT", "SUR", "RVR", "AOARCID", "ARCADDR", "CSTAT", "ESTDATA", "SRC", "ORGN", "RFP", "RIF", "EUR", "IFP", "MODIFNB", "IOBD", "IOBT",
+		"SPLA", "SPLC", "SPLDCAP", "SPLDCOL", "SPLDCOV", "SPLDNB", "SPLE", "SPLJ", "SPLN", "SPLP", "SPLR", "SPLS",
+		"EETLAT", "EETLONG", "STAY", "STAYINFO",
+	}
+
+	// points are the auxiliary points the route of a message may refer to, defined by their coordinates or from a point of reference
+	points = []string{"GEO", "REF"}
+
+	// amended are the previous values of the fields identifying a flight, given by an ICHG when they are changed
+	amended = []string{"ARCIDOLD", "ADEPOLD", "ADESOLD", "EOBDOLD", "EOBTOLD"}
+
+	// slot are the fields describing the slot of a regulated flight
+	slot = []string{"IFPLID", "TAXITIME", "REGUL", "REGCAUSE", "TTOT", "MINLINEUP", "NEWRTE", "RVR", "RESPBY", "REASON", "ATFMDELAY"}
+
+	// reply are the fields of the replies to a submitted message
+	reply = []string{"MSGSUM", "ORGNID", "ORGMSG", "ORGRTE", "MSGTXT", "ERROR", "ERRFIELD"}
+)
+
+// join concatenates groups of fields
+func join(groups ...[]string) []string {
+	var list []string
+	for _, g := range groups {
+		list = append(list, g...)
+	}
+	return list
+}
+
+// plan and change are the rules of the messages giving a whole flight plan, and changing it
+var (
+	plan   = Rule{Mandatory: join(flight, []string{"ARCTYP", "FLTRUL", "FLTTYP", "ROUTE"}), Optional: join(header, flightPlan, points)}
+	change = Rule{Mandatory: flight, Optional: join(header, flightPlan, points, amended)}
+)
+
+// known are the rules of the IFPS & ETFMS messages, by title
+var known = map[string]Rule{
+	"IFPL": plan,
+	"IAPL": plan,
+	"IAFP": plan,
+	"IRPL": plan,
+	"ICHG": change,
+	"IACH": change,
+	"ICNL": {Mandatory: flight, Optional: header},
+	"IDLA": {Mandatory: flight, Optional: header},
+	"IDEP": {Mandatory: []string{"ARCID", "ADEP", "ADES", "ATD"}, Optional: join(header, []string{"IFPLID", "EOBD", "EOBT"})},
+	// The destination is only given by an ICAO ARR in case of a diversionary landing (Doc 4444, appendix 2, field 16), ADARR being the arrival aerodrome
+	"IARR": {Mandatory: []string{"ARCID", "ADEP", "ADARR", "ATA"}, Optional: join(header, []string{"IFPLID", "ADES", "EOBD", "EOBT", "ADARRZ"})},
+	"ACK":  {Mandatory: []string{"MSGTYP"}, Optional: join(header, flight, reply)},
+	"REJ":  {Mandatory: []string{"MSGTYP"}, Optional: join(header, flight, reply)},
+	"SAM":  {Mandatory: []string{"ARCID", "ADEP", "ADES", "EOBD", "EOBT", "CTOT", "REGUL"}, Optional: join(header, slot, points)},
+	"SRM":  {Mandatory: []string{"ARCID", "ADEP", "ADES", "EOBD", "EOBT", "NEWCTOT", "REGUL"}, Optional: join(header, slot, points, []string{"CTOT"})},
+	"SLC":  {Mandatory: []string{"ARCID", "ADEP", "ADES", "EOBD", "EOBT", "REASON"}, Optional: join(header, slot, points)},
+	"FLS":  {Mandatory: []string{"ARCID", "ADEP", "ADES", "EOBD", "EOBT"}, Optional: join(header, slot, points)},
+	"DES":  {Mandatory: []string{"ARCID", "ADEP", "ADES", "EOBD", "EOBT"}, Optional: join(header, slot, points)},
+}
+
+// rules are the rules of every title of the catalog, the titles without a known rule having an open one
+var rules = func() map[string]*Rule {
+	table := make(map[string]*Rule, len(titles))
+	for title := range titles {
+		r, ok := known[title]
+		if !ok {
+			r = Rule{Open: true}
+		}
+		r.Title = title
+		table[title] = &r
+	}
+	return table
+}()
+
+// allowed indexes the keywords allowed by each rule, for quick lookups
+var allowed = func() map[string]map[string]struct{} {
+	index := make(map[string]map[string]struct{}, len(rules))
+	for title, r := range rules {
+		index[title] = make(map[string]struct{}, len(r.Mandatory)+len(r.Optional))
+		for _, k := range join(r.Mandatory, r.Optional) {
+			index[title][k] = struct{}{}
+		}
+	}
+	return index
+}()
@@ -0,0 +1,3336 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package catalog
+
+var fields = map[string]*Field{
+	"AATOT": {
+		Keyword:  "AATOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AATOT\" timehhmm",
+		Semantic: "The Anticipated Actual Take-Off Time (AATOT) of the flight.",
+	},
+	"AD": {
+		Keyword:  "AD",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"AD\" adid [(fl | flblock)] [eto] [to] [cto] [sto] [ptstay] [ptrfl] [ptrulchg] [(ptspeed | ptmach)]",
+		Semantic: "The designator of an aerodrome in cases where the aerodrome forms part of the route description, additional routing information may be provided.",
+		Children: []string{"ADID", "CTO", "ETO", "FL", "FLBLOCK", "PTMACH", "PTRFL", "PTRULCHG", "PTSPEED", "PTSTAY", "STO", "TO"},
+		Parents:  []string{"RTEPTS"},
+	},
+	"ADA": {
+		Keyword:  "ADA",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADA\" date",
+		Semantic: "Actual date of arrival.",
+	},
+	"ADARR": {
+		Keyword:  "ADARR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADARR\" (icaoaerodrome | 'ZZZZ')",
+		Semantic: "Actual aerodrome of arrival.",
+	},
+	"ADARRZ": {
+		Keyword:  "ADARRZ",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADARRZ\" 1{LIM_CHAR}20",
+		Semantic: "Name of actual aerodrome of arrival if no ICAO location indicator exists.",
+	},
+	"ADD": {
+		Keyword:  "ADD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADD\" date",
+		Semantic: "Actual date of departure.",
+	},
+	"ADDR": {
+		Keyword:  "ADDR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'- \"BEGIN\" \"ADDR\" 1 { fac } '-' \"END\" \"ADDR\"",
+		Semantic: "List of addressees.",
+		Children: []string{"FAC"},
+	},
+	"ADDRINFO": {
+		Keyword:  "ADDRINFO",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"ADDRINFO\" networktype fac",
+		Semantic: "Address information",
+		Children: []string{"FAC", "NETWORKTYPE"},
+	},
+	"ADEP": {
+		Keyword:  "ADEP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADEP\" (icaoaerodrome | 'AFIL' | 'ZZZZ')",
+		Semantic: "ICAO location indicator of the aerodrome of departure or the indication 'AFIL' meaning an air-filed flight plan or 'ZZZZ' whennoICAOlocationindicatorisassignedtothe aerodrome of departure.",
+		Parents:  []string{"IFPDSUM", "MSGSUM", "RFPDSUM"},
+	},
+	"ADEPK": {
+		Keyword:  "ADEPK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADEPK\" (icaoaerodrome | 'AFIL' | 'ZZZZ' | icaoaerodromewldcrd)",
+		Semantic: "Aerodrome of departure used as database key in a query, maybewild-carded. MaycontainanICAOlocationindicatorortheindication 'AFIL' meaning an air-filed flight plan or 'ZZZZ' when no ICAOlocationindicatorisassignedtotheaerodromeof departureoracombinationofalphabeticandwildcard characters.",
+	},
+	"ADEPOLD": {
+		Keyword:  "ADEPOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADEPOLD\" (icaoaerodrome | 'AFIL' | 'ZZZZ')",
+		Semantic: "The\"previous\"aerodromeofdeparture.Maycontainthe ICAO location indicator or the indication 'AFIL' meaning an air-filed flight plan or 'ZZZZ' when no ICAO location indicator is assigned to the aerodrome of departure.",
+	},
+	"ADES": {
+		Keyword:  "ADES",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADES\" (icaoaerodrome | 'ZZZZ')",
+		Semantic: "The ICAO location indicator of the aerodrome of destination or 'ZZZZ' when no ICAO location indicator is assigned to the aerodrome of destination.",
+		Parents:  []string{"IFPDSUM", "MSGSUM", "RFPDSUM"},
+	},
+	"ADESK": {
+		Keyword:  "ADESK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-'\"ADESK\"(icaoaerodrome|'ZZZZ'| icaoaerodromewldcrd)",
+		Semantic: "The aerodrome of destination used as database key in a query,maybewild-carded. May contain an ICAO location indicator or 'ZZZZ' when no ICAO location indicator has been assigned to the aerodrome of destination or a combination of alphabetic and wildcard characters.",
+	},
+	"ADESOLD": {
+		Keyword:  "ADESOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADESOLD\" (icaoaerodrome | 'ZZZZ')",
+		Semantic: "The \"previous\" aerodrome of destination. May contain the ICAO location indicator or 'ZZZZ' when no ICAO location indicator has been assigned to the aerodrome of destination.",
+	},
+	"ADEXPTXT": {
+		Keyword:  "ADEXPTXT",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"ADEXPTXT\" (preproctxt | postproctxt)",
+		Semantic: "Contains an ADEXP message.",
+		Children: []string{"POSTPROCTXT", "PREPROCTXT"},
+	},
+	"ADID": {
+		Keyword:  "ADID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADID\" icaoaerodrome | 'ZZZZ'",
+		Semantic: "Thedesignatorofanaerodrome. MaycontaintheICAOlocation indicatororthecharacters'ZZZZ' wherenolocationindicatorhas been assigned.",
+		Parents:  []string{"AD", "PLANNEDPOSITION", "POSITION", "STAY"},
+	},
+	"ADNAME": {
+		Keyword:  "ADNAME",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADNAME\" 1{LIM_CHAR}50",
+		Semantic: "Name of an aerodrome.",
+		Parents:  []string{"ALTNZ", "DEPZ", "DESTZ"},
+	},
+	"ADSADDRESS": {
+		Keyword:  "ADSADDRESS",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ADSADDRESS\" (36{hexadecimal} 36) | (38{hexadecimal}38)",
+		Semantic: "TheATNaddressoftheADS application. Must contain thirty six or thirty eight ofthedefinedcharactersinany order, with or without repetition.",
+		Parents:  []string{"ADSQVLTSP"},
+	},
+	"ADSQVLTSP": {
+		Keyword:  "ADSQVLTSP",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-'\"ADSQVLTSP\"agappqualifier agappversion adsaddress'",
+		Semantic: "Parameter containing the ATN ADS applicationtype,versionand address.",
+		Children: []string{"ADSADDRESS", "AGAPPQUALIFIER", "AGAPPVERSION"},
+		Parents:  []string{"ATNLOGON"},
+	},
+	"AF": {
+		Keyword:  "AF",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AF\" \"ATN\" | \"FANS1A\"",
+		Semantic: "Type of logon parameters ATN or FANS/1A.",
+	},
+	"AFILDATA": {
+		Keyword:  "AFILDATA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"AFILDATA\" ptid fl eto",
+		Semantic: "Estimatedataforanair-filedflightplan. A point identification, the joining flight level and the estimate date-timeatthepoint. NOTE: The flight level indicated is the level at which the flight has been cleared to join controlled airspace over the point indicated. It need not be the same as the RFL.",
+		Children: []string{"ETO", "FL", "PTID"},
+	},
+	"AFREGULLIST": {
+		Keyword:  "AFREGULLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"AFREGULLIST\" { regul } '-' \"END\" \"AFREGULLIST\"",
+		Semantic: "List of ATFCM regulations that affect a flight.",
+		Children: []string{"REGUL"},
+	},
+	"AGAPPQUALIFIER": {
+		Keyword:  "AGAPPQUALIFIER",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"AGAPPQUALIFIER\" 1{'0' | '2' | '3' | '22'} 1",
+		Semantic: "ATNair/groundapplicationtype. Mustcontainoneofthedefined character groups.",
+		Parents:  []string{"ADSQVLTSP", "ATIQV", "CPCQVLTSP"},
+	},
+	"AGAPPVERSION": {
+		Keyword:  "AGAPPVERSION",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"AGAPPVERSION\" 3{ '00' | '01' | '02'} 3",
+		Semantic: "ATNair/groundapplicationversion for all 3 applications.",
+		Parents:  []string{"ADSQVLTSP", "ATIQV", "CPCQVLTSP"},
+	},
+	"AHEAD": {
+		Keyword:  "AHEAD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AHEAD\" (heading | \"ZZZ\")",
+		Semantic: "Theheadingassignedtoaflight,expressedindegrees Must be a three digit numeric or the value 'ZZZ' indicating that no heading is assigned.",
+	},
+	"AIRROUTE": {
+		Keyword:  "AIRROUTE",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"AIRROUTE\" [num] refatsrte flblock valperiod [remark]",
+		Semantic: "Description of all or part of an ATS route during a specified period.",
+		Children: []string{"FLBLOCK", "NUM", "REFATSRTE", "REMARK", "VALPERIOD"},
+		Parents:  []string{"LACDR", "LCATSRTE"},
+	},
+	"AIRSPACE": {
+		Keyword:  "AIRSPACE",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"AIRSPACE\" [num] airspdes flblock valperiod respunit [remark]",
+		Semantic: "Descriptionofallorpartofan airspace during a specified period.",
+		Children: []string{"AIRSPDES", "FLBLOCK", "NUM", "REMARK", "RESPUNIT", "VALPERIOD"},
+		Parents:  []string{"LATSA", "LRAR"},
+	},
+	"AIRSPDES": {
+		Keyword:  "AIRSPDES",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"AIRSPDES\" 3 { ALPHANUM }12",
+		Semantic: "Designates an airspace other than an ATS route.",
+		Parents:  []string{"AIRSPACE", "ASP", "ENTRYDATA"},
+	},
+	"ALTNZ": {
+		Keyword:  "ALTNZ",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"ALTNZ\" [adname ( [ geoid | refid ] ) | ptid]",
+		Semantic: "Name of destination alternate aerodrome if no ICAO location indicator exists. Optionally, the location of the aerodrome if it isnotlistedinthenationalAIPgivenbybearingand distance or Lat. Long. Alternatively, if the aircraft did not depart from an aerodrome, the first point of the route given by Waypoint/Nav Aid or Lat. Long.",
+		Children: []string{"ADNAME", "GEOID", "PTID", "REFID"},
+	},
+	"ALTRNT1": {
+		Keyword:  "ALTRNT1",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ALTRNT1\" (icaoaerodrome | 'ZZZZ')",
+		Semantic: "First alternate aerodrome, as ICAO Field 16c.",
+	},
+	"ALTRNT2": {
+		Keyword:  "ALTRNT2",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ALTRNT2\" (icaoaerodrome | 'ZZZZ')",
+		Semantic: "TheICAOlocationindicatoroftheseconddestination alternate aerodrome or the indicator 'ZZZZ' when no ICAO location indicator has been assigned to the aerodrome.",
+	},
+	"AMANTIME": {
+		Keyword:  "AMANTIME",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AMANTIME\" timehhmm",
+		Semantic: "Thetimeatwhichaflightshouldbeoverheadthe appropriate Coordination Point (COP) as calculated by the arrival manager.",
+	},
+	"AOARCID": {
+		Keyword:  "AOARCID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AOARCID\" 3{ALPHA}3",
+		Semantic: "The ICAO three-letter designator of the aircraft operator as indicated in the aircraft identification, ARCID or ICAO Field 7a.",
+	},
+	"AOBD": {
+		Keyword:  "AOBD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AOBD\" date",
+		Semantic: "Actual Off_Block Date.",
+	},
+	"AOBT": {
+		Keyword:  "AOBT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AOBT\" timehhmm",
+		Semantic: "Actual Off_Block Time.",
+	},
+	"AOOPR": {
+		Keyword:  "AOOPR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AOOPR\" 3{ALPHA}3",
+		Semantic: "The ICAO three-letter designator of the aircraft operator as derived from the OPR/ element of ICAO Field 18.",
+	},
+	"APPLIPT": {
+		Keyword:  "APPLIPT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"APPLIPT\" point",
+		Semantic: "An identifier for a point at which an ATC constraint applies, eitheracodeddesignatorofapointoranamegiven artificially (GEOxx, RENxx or REFxx).",
+	},
+	"APPNAME": {
+		Keyword:  "APPNAME",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"APPNAME\" 'ADS' I 'ATC'",
+		Semantic: "FANSATNair/groundapplication name",
+		Parents:  []string{"FANSLOGON"},
+	},
+	"APPTOT": {
+		Keyword:  "APPTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"APPTOT\" timehhmm",
+		Semantic: "The approved take off time is the time at which the flight should take off at the aerodrome as approved by the next ATC unit.",
+	},
+	"APPVERSION": {
+		Keyword:  "APPVERSION",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"APPVERSION\" 2{ '00' | '01'}2",
+		Semantic: "FANS air/ground application version for all 2 applications.",
+		Parents:  []string{"FANSLOGON"},
+	},
+	"ARCADDR": {
+		Keyword:  "ARCADDR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ARCADDR\" ( 6{hexadecimal}6 | 'NIL' )",
+		Semantic: "TheICAO24-bitaircraftaddressasusedforModeS, Datalink.The'NIL'indicationisusedtosuppressa previously provided aircraft address.",
+	},
+	"ARCID": {
+		Keyword:  "ARCID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ARCID\" aircraftid",
+		Semantic: "Aircraft Identification. May be the registration marking of the aircraft, or the ICAO designator of the aircraft operator followed by the flight identifier.",
+		Parents:  []string{"IFPDSUM", "MSGSUM", "RFPDSUM"},
+	},
+	"ARCIDK": {
+		Keyword:  "ARCIDK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ARCIDK\" (aircraftid | aircraftidwldcrd)",
+		Semantic: "Aircraft Identification used as database key in a query; may be wild-carded. Must be a combination of alphanumeric and wild-card characters up to maximum 7 characters in total.",
+	},
+	"ARCIDOLD": {
+		Keyword:  "ARCIDOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' ARCIDOLD aircraftid",
+		Semantic: "The \"previous\" aircraft id. Where the aircraft id. is to be amended, the new value will be given in \"ARCID\".",
+	},
+	"ARCTYP": {
+		Keyword:  "ARCTYP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ARCTYP\" (icaoaircrafttype | \"ZZZZ\")",
+		Semantic: "Type of aircraft (ICAO identification of the type) or ZZZZ.",
+	},
+	"AREASTS": {
+		Keyword:  "AREASTS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-'\"AREASTS\"(\"ACTIVE\"|\"INACTIVE\")!1 {LIM_CHAR}",
+		Semantic: "The status of an airspace expressed as free text indicating if the area is active or inactive and the type of activity.",
+	},
+	"ARRSEQNUMBER": {
+		Keyword:  "ARRSEQNUMBER",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ARRSEQNUMBER\" 2{ DIGIT }2",
+		Semantic: "An arrival sequence number.",
+	},
+	"ASP": {
+		Keyword:  "ASP",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"ASP\" airspdes eti xti",
+		Semantic: "Designator of the airspace and entry and exit times.",
+		Children: []string{"AIRSPDES", "ETI"},
+		Parents:  []string{"ASPLIST"},
+	},
+	"ASPEED": {
+		Keyword:  "ASPEED",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ASPEED\" (spd | machnumber | \"ZZZ\")",
+		Semantic: "The currently assigned speed of the flight, in kilometres per hour,knotsorMachnumber. Must be 'M' followed by three digits, 'K' or 'N' followed by four digits or 'ZZZ' indicating that no speed restriction is assigned.",
+	},
+	"ASPLIST": {
+		Keyword:  "ASPLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"ASPLIST\"{asp}'-'\"END\" \"ASPLIST\"",
+		Semantic: "List of airspaces crossed by a flight.",
+		Children: []string{"ASP"},
+	},
+	"ATA": {
+		Keyword:  "ATA",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ATA\" timehhmm",
+		Semantic: "Actual time of arrival.",
+	},
+	"ATD": {
+		Keyword:  "ATD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ATD\" timehhmm",
+		Semantic: "Actual time of departure.",
+	},
+	"ATFMDELAY": {
+		Keyword:  "ATFMDELAY",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ATFMDELAY\" timehhmm",
+		Semantic: "The ATFM delay allocated to a flight.",
+	},
+	"ATIQV": {
+		Keyword:  "ATIQV",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"ATIQV\" agappqualifier agappversion",
+		Semantic: "Parameter containing the ATN ATI applicationtypeandATNATI application version.",
+		Children: []string{"AGAPPQUALIFIER", "AGAPPVERSION"},
+		Parents:  []string{"ATNLOGON"},
+	},
+	"ATNLOGON": {
+		Keyword:  "ATNLOGON",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-'\"ATNLOGON\"cmltspadsqvltspcpcqvltsp atiqv",
+		Semantic: "Logon parameters for ATN aircraft.",
+		Children: []string{"ADSQVLTSP", "ATIQV", "CMLTSP", "CPCQVLTSP"},
+	},
+	"ATOT": {
+		Keyword:  "ATOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ATOT\" timehhmm",
+		Semantic: "Actual Time of Take-off",
+	},
+	"ATSRT": {
+		Keyword:  "ATSRT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ATSRT\" atsroute point point",
+		Semantic: "ATS route designator and identifiers of first and last points.",
+	},
+	"ATTOT": {
+		Keyword:  "ATTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ATTOT\" timehhmm",
+		Semantic: "The Aircraft operator Target Take-Off Time (ATTOT) of the flight.",
+	},
+	"AWR": {
+		Keyword:  "AWR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"AWR\" \"R\" ! 1{ \"1\" | \"2\" | \"3\" | \"4\" | \"5\" | \"6\" | \"7\" | \"8\" | \"9\" }1",
+		Semantic: "A reference included in the FPL when the flight has been re- routed using the 'AO What-If-Reroute' mechanism.",
+	},
+	"BRNG": {
+		Keyword:  "BRNG",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"BRNG\" refbearing",
+		Semantic: "Bearing of a point from a navigation aid in degrees magnetic.",
+		Parents:  []string{"REF"},
+	},
+	"CASSADDR": {
+		Keyword:  "CASSADDR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"CASSADDR\"{fac}'-'\"END\" \"CASSADDR\"",
+		Semantic: "Addresses to which ATFM messages should be addressed.",
+		Children: []string{"FAC"},
+	},
+	"CDA": {
+		Keyword:  "CDA",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CDA\" date",
+		Semantic: "Calculated Date of Arrival",
+	},
+	"CEQPT": {
+		Keyword:  "CEQPT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CEQPT\" aidequipment",
+		Semantic: "Radiocommunication, navigation and approach aid equipment and capabilities, as ICAO Field 10a.",
+	},
+	"CFL": {
+		Keyword:  "CFL",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"CFL\" fl [ptid] [sfl]",
+		Semantic: "Cleared Flight Level. The level currently assigned by ATC to theflight.Itmayoptionallyincludeapointandalevel restriction at the point..",
+		Children: []string{"FL", "PTID", "SFL"},
+	},
+	"CHGRUL": {
+		Keyword:  "CHGRUL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CHGRUL\" ( rulechg | flighttypechg | rulechg flighttypechg ) point",
+		Semantic: "Indication of a change in either the \"flight rules\"(VFR/IFR) or the \"type of flight\"(OAT/GAT) or both together with the point at which the change occurs.",
+	},
+	"CMLTSP": {
+		Keyword:  "CMLTSP",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"CMLTSP\" hexaddr",
+		Semantic: "Transportlayeraddress,which definestheCMapplicationofthe aircraft.",
+		Children: []string{"HEXADDR"},
+		Parents:  []string{"ATNLOGON"},
+	},
+	"COBD": {
+		Keyword:  "COBD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"COBD\" date",
+		Semantic: "Calculated Off-Block Date.",
+	},
+	"COBT": {
+		Keyword:  "COBT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"COBT\" timehhmm",
+		Semantic: "Calculated Off-Block Time.",
+	},
+	"COM": {
+		Keyword:  "COM",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"COM\" 1 {LIM_CHAR} 50",
+		Semantic: "AsICAOField18COM/.Itindicatescommunications applications or capabilities.",
+	},
+	"COMMENT": {
+		Keyword:  "COMMENT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"COMMENT\" 1 { LIM_CHAR }",
+		Semantic: "A general comment in free text without hyphen.",
+	},
+	"CONDID": {
+		Keyword:  "CONDID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CONDID\" 1 {LIM_CHAR} 30",
+		Semantic: "Identificationofan'exceptionalcondition'raisedinthe context of ATFM.",
+	},
+	"CONDITION": {
+		Keyword:  "CONDITION",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CONDITION\" 2 {ALPHA} 20",
+		Semantic: "Type of condition or restriction e.g. TOS, FL restriction.",
+		Parents:  []string{"IGNORE"},
+	},
+	"COORDATA": {
+		Keyword:  "COORDATA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"COORDATA\" ptid (to | sto) tfl [sfl]",
+		Semantic: "The transfer conditions of a flight. A point id., the flight level and estimated time at that point and optional supplementary flight level information.",
+		Children: []string{"PTID", "SFL", "STO", "TFL", "TO"},
+	},
+	"COP": {
+		Keyword:  "COP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"COP\" point",
+		Semantic: "A co-ordination point identifier, either a coded designator of apointoranamegivenartificially(GEOxx,RENxxor REFxx).",
+	},
+	"CPCQVLTSP": {
+		Keyword:  "CPCQVLTSP",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-'\"CPCQVLTSP\"agappqualifier agappversion cpdlcaddress",
+		Semantic: "ParametercontainingtheATN CPDLCapplicationtype,version and address.",
+		Children: []string{"AGAPPQUALIFIER", "AGAPPVERSION", "CPDLCADDRESS"},
+		Parents:  []string{"ATNLOGON"},
+	},
+	"CPDLCADDRESS": {
+		Keyword:  "CPDLCADDRESS",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CPDLCADDRESS\" 36{hexadecimal}36) | (38{hexadecimal}38)",
+		Semantic: "TheATNaddressoftheCPDLC application. Must contain thirty six or thirty eight ofthedefinedcharactersinany order, with or without repetition.",
+		Parents:  []string{"CPCQVLTSP"},
+	},
+	"CRFL1": {
+		Keyword:  "CRFL1",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CRFL1\" flightlevel",
+		Semantic: "The lower limit of the flight level band within which a cruise climb is requested.",
+		Parents:  []string{"CRSCLIMB", "PTCRSCLIMB"},
+	},
+	"CRFL2": {
+		Keyword:  "CRFL2",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CRFL2\" (flightlevel | \"PLUS\")",
+		Semantic: "Theupperlimitoftheflightlevel band within which a cruise climb is requested. \"PLUS\" where the upper limit is unknown.",
+		Parents:  []string{"CRSCLIMB", "PTCRSCLIMB"},
+	},
+	"CRMACH": {
+		Keyword:  "CRMACH",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CRMACH\" machnumber",
+		Semantic: "The Mach No. maintained during a cruise climb.",
+		Parents:  []string{"CRSCLIMB", "PTCRSCLIMB"},
+	},
+	"CRSCLIMB": {
+		Keyword:  "CRSCLIMB",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-'\"CRSCLIMB\"ptid(crspeed|crmach)crfl1 crfl2",
+		Semantic: "Indication of a cruiseclimb. Giving the point at which the climbwillbegin,speedormachno.andthetwolevels indicating the flight level band to be occupied during the climb. The second level may be \"PLUS\" where the upper level is unknown.",
+		Children: []string{"CRFL1", "CRFL2", "CRMACH", "CRSPEED", "PTID"},
+	},
+	"CRSPEED": {
+		Keyword:  "CRSPEED",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CRSPEED\" spd",
+		Semantic: "The speed to be maintained during a cruise climb.",
+		Parents:  []string{"CRSCLIMB", "PTCRSCLIMB"},
+	},
+	"CSTAT": {
+		Keyword:  "CSTAT",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"CSTAT\" statid [statreason]",
+		Semantic: "An indicator confirming the new co-ordination status of a flight and, optionally, the reason for the change.",
+		Children: []string{"STATID", "STATREASON"},
+	},
+	"CTA": {
+		Keyword:  "CTA",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CTA\" timehhmm",
+		Semantic: "Calculated Time of Arrival",
+	},
+	"CTO": {
+		Keyword:  "CTO",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"CTO\" timehhmm",
+		Semantic: "Calculated Time Over a point.",
+		Parents:  []string{"AD", "PLANNEDPOSITION", "POSITION", "PT"},
+	},
+	"CTOD": {
+		Keyword:  "CTOD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CTOD\" date",
+		Semantic: "Calculated Take-Off Date.",
+	},
+	"CTOT": {
+		Keyword:  "CTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"CTOT\" timehhmm",
+		Semantic: "CalculatedTake-OffTime(CTOT):referencetimeofan ATFM Slot.",
+	},
+	"DAT": {
+		Keyword:  "DAT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DAT\" datalink",
+		Semantic: "Indication of the data applications and capabilities carried by the aircraft.",
+	},
+	"DAYS": {
+		Keyword:  "DAYS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DAYS\" numdays",
+		Semantic: "Days of operation for a repetitive flight plan (1234567 where 1 is for Monday, 2 for Tuesday, ..., with 0 in columns of non- operation).",
+		Parents:  []string{"MSGSUM", "RFPDSUM"},
+	},
+	"DAYSK": {
+		Keyword:  "DAYSK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DAYSK\" (numdays | numdayswldcrd)",
+		Semantic: "Daysofoperationforarepetitiveflightplan,usedas database key in a query message, may be wildcarded.",
+	},
+	"DAYSOLD": {
+		Keyword:  "DAYSOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DAYSOLD\" numdays",
+		Semantic: "The \"previous\" days of operation. Used as a database key. Where the days of operation of an RPL are to be amended, the new values will be given in \"DAYS\".",
+	},
+	"DCT": {
+		Keyword:  "DCT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DCT\" point point",
+		Semantic: "Indicatesadirectroutebetweentwopoints. The points may either be a valid ICAO designator of a point or a point appearing in a GEO, REN or REF field of the form GEOxx, RENxx or REFxx.",
+	},
+	"DELAY": {
+		Keyword:  "DELAY",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DELAY\" timehhmm",
+		Semantic: "A period of time representing a delay. The nature of the delayi.e.delaytoaflight,processingdelay,etc.is dependant upon its context.",
+	},
+	"DEPSTATUS": {
+		Keyword:  "DEPSTATUS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DEPSTATUS\" 1 {LIM_CHAR}",
+		Semantic: "Indicates the status of the flight prior to the departure, e.g. \"DEICING\".",
+	},
+	"DEPZ": {
+		Keyword:  "DEPZ",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"DEPZ\" \" (adname [ geoid | refid ]) | ptid",
+		Semantic: "Name of departure aerodrome if no ICAO location indicator exists. Optionally, the location of the aerodrome if it is not listed in the national AIP given by bearing and distance or Lat. Long. Alternatively, if the aircraft did not depart from an aerodrome,thefirstpointoftheroutegivenby Waypoint/Nav Aid or Lat. Long.",
+		Children: []string{"ADNAME", "GEOID", "PTID", "REFID"},
+	},
+	"DESC": {
+		Keyword:  "DESC",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DESC\" 1 {LIM_CHAR}",
+		Semantic: "Description of a condition or entity which is of relevance to the content of the message.",
+	},
+	"DESTZ": {
+		Keyword:  "DESTZ",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"DESTZ\" \" (adname [ geoid | refid ] ) | ptid",
+		Semantic: "Name of destination aerodrome if no ICAO location indicator exists. Optionally, the location of the aerodrome if it is not listed in the national AIP given by bearing and distance or Lat. Long. Alternatively, if the aircraft did not depart from an aerodrome,thefirstpointoftheroutegivenby Waypoint/Nav Aid or Lat. Long.",
+		Children: []string{"ADNAME", "GEOID", "PTID", "REFID"},
+	},
+	"DISTNC": {
+		Keyword:  "DISTNC",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"DISTNC\" 1{ DIGIT }3",
+		Semantic: "Distanceofapointfroma navigationaidinnauticalmiles. Must be 1 to 3 digits, possibly with leading zeroes.",
+		Parents:  []string{"REF"},
+	},
+	"DPISTATUS": {
+		Keyword:  "DPISTATUS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"DPISTATUS\" (\"EARLY\" | \"PROV\" | \"TARGET\" | \"SEQ\" | \"ATC\" | \"CNL\")",
+		Semantic: "The status of the DPI Message. It indicates the sub-type of the DPI message.",
+	},
+	"EETFIR": {
+		Keyword:  "EETFIR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EETFIR\" firindicator timehhmm_elapsed",
+		Semantic: "FIRidentificationandtheaccumulatedelapsedtime(in hours and minutes) to the FIR boundary.",
+	},
+	"EETLAT": {
+		Keyword:  "EETLAT",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"EETLAT\" lattd time",
+		Semantic: "Indication of an elapsed time to a position given by latitude only.",
+		Children: []string{"LATTD", "TIME"},
+	},
+	"EETLONG": {
+		Keyword:  "EETLONG",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"EETLONG\" longtd time",
+		Semantic: "Indication of an elapsed time to a position given by longitude only.",
+		Children: []string{"LONGTD", "TIME"},
+	},
+	"EETPT": {
+		Keyword:  "EETPT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EETPT\" point timehhmm_elapsed",
+		Semantic: "Pointidentifierandtheaccumulatedelapsedtimetothe point.",
+	},
+	"EFL": {
+		Keyword:  "EFL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"EFL\" flightlevel",
+		Semantic: "Estimated flight level.",
+	},
+	"ELDT": {
+		Keyword:  "ELDT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ELDT\" date ! timehhmm ! seconds",
+		Semantic: "The Estimated Landing Time.",
+	},
+	"ENDREG": {
+		Keyword:  "ENDREG",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ENDREG\" day!timehhmm",
+		Semantic: "ThetimeatwhichanATFM Regulation finishes.",
+		Parents:  []string{"REGULATION"},
+	},
+	"ENDTIME": {
+		Keyword:  "ENDTIME",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ENDTIME\" day ! timehhmm",
+		Semantic: "The time at which a period of time ends.",
+	},
+	"ENTRYDATA": {
+		Keyword:  "ENTRYDATA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-'\"ENTRYDATA\"(ptid|airspdes|(ptid airspdes)) [fl] [ptrfl] [(ptspeed | ptmach)] [ptfltrul] [ptmilrul]",
+		Semantic: "The flight plan data which is applicable to a flight at the point given or at the entry of the flight into the airspace concerned. One or both of the fields; 'ptid', 'airspdes', must be present.",
+		Children: []string{"AIRSPDES", "FL", "PTFLTRUL", "PTID", "PTMACH", "PTMILRUL", "PTRFL", "PTSPEED"},
+	},
+	"EOBD": {
+		Keyword:  "EOBD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EOBD\" date",
+		Semantic: "Estimated Off-Block Date.",
+		Parents:  []string{"MSGSUM"},
+	},
+	"EOBDK": {
+		Keyword:  "EOBDK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EOBDK\" date",
+		Semantic: "Estimated Off-Block Date used as database key in a query, maybewildcarded. Must be a combination of digits and wild-card characters, up to maximum 6 characters in total.",
+	},
+	"EOBDOLD": {
+		Keyword:  "EOBDOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EOBDOLD\" date",
+		Semantic: "The \"previous\" estimated off block date. Used as a database key. Where the estimated off block date is to be amended, the new value will be given in \"EOBD\".",
+	},
+	"EOBT": {
+		Keyword:  "EOBT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EOBT\" timehhmm",
+		Semantic: "Estimated Off-Block Time (EOBT)",
+		Parents:  []string{"IFPDSUM", "MSGSUM", "RFPDSUM"},
+	},
+	"EOBTK": {
+		Keyword:  "EOBTK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EOBTK\" (timehhmm | timewldcrd)",
+		Semantic: "Estimated Off-Block Time used as database key in a query, may be wildcarded.",
+	},
+	"EOBTOLD": {
+		Keyword:  "EOBTOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EOBTOLD\" timehhmm",
+		Semantic: "The \"previous\" estimated off block time. Used as a database key. Where the estimated off block date is to be amended, the new value will be given in \"EOBT\".",
+	},
+	"EQCST": {
+		Keyword:  "EQCST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \" EQCST\" 1{eqpt | sureqpt } '-' \"END\" \" EQCST\"",
+		Semantic: "List of equipment capability codes each followed by a status value which specifies the current status of the capability.",
+		Children: []string{"EQPT", "SUREQPT"},
+	},
+	"EQPT": {
+		Keyword:  "EQPT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"EQPT\" eqptcode ! '/' ! eqptstatus",
+		Semantic: "Equipment capability code followed by a status value which specifies the current status of the capability.",
+		Parents:  []string{"EQCST"},
+	},
+	"ERRFIELD": {
+		Keyword:  "ERRFIELD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ERRFIELD\" fieldid",
+		Semantic: "ADEXP name of erroneous field(s).",
+	},
+	"ERROR": {
+		Keyword:  "ERROR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ERROR\" [errorcode] 1{ LIM_CHAR }",
+		Semantic: "Errormessagetext.Mayoptionallycontainanerror identification code.",
+	},
+	"ESTDATA": {
+		Keyword:  "ESTDATA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"ESTDATA\" ptid eto fl [sfl]",
+		Semantic: "Estimate data. A point id., the estimated flight level (flight levelnumber)andtheestimatedate-timeatthispoint followed optionally by the supplementary flight level (flight level number followed by the indicator A or B).",
+		Children: []string{"ETO", "FL", "PTID", "SFL"},
+	},
+	"ETI": {
+		Keyword:  "ETI",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ETI\" datetime ! seconds",
+		Semantic: "The entry time of an airspace or a regulation.",
+		Parents:  []string{"ASP"},
+	},
+	"ETO": {
+		Keyword:  "ETO",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ETO\" date ! timehhmm ! seconds",
+		Semantic: "EstimatedTimeOverapoint,in year,month,day,hours,minutes and seconds.",
+		Parents:  []string{"AD", "AFILDATA", "ESTDATA", "PT", "VEC"},
+	},
+	"ETOD": {
+		Keyword:  "ETOD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ETOD\" date",
+		Semantic: "Estimated Take_Off Date.",
+	},
+	"ETOT": {
+		Keyword:  "ETOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ETOT\" timehhmm",
+		Semantic: "Estimated Take-Off Time.",
+	},
+	"EUR": {
+		Keyword:  "EUR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EUR\" eurflightplanstatus",
+		Semantic: "Indicatesspecificstatus,capabilitiesorlackthereof,as prescribed for use within the EUR region.",
+	},
+	"EVENT": {
+		Keyword:  "EVENT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EVENT\" eventtype",
+		Semantic: "Triggering event.",
+	},
+	"EVENTCLASS": {
+		Keyword:  "EVENTCLASS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"EVENTCLASS\" atfmreasonclass",
+		Semantic: "Classification of an event.",
+	},
+	"EXCCOND": {
+		Keyword:  "EXCCOND",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-'\"EXCCOND\"regnumrefloc regreasonstartregendreg[flblock] [rvrlimit] [remark]",
+		Semantic: "An \"exceptional condition\" raised in the context of ATFM e.g. fog at an aerodrome.",
+		Children: []string{"FLBLOCK", "REMARK", "RVRLIMIT"},
+		Parents:  []string{"REGLIST"},
+	},
+	"EXTADDR": {
+		Keyword:  "EXTADDR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-'\"EXTADDR\" num | { fac } | (num {fac})",
+		Semantic: "Addresses which are provided in addition to those which are determined automatically i.e. 'extra addresses'. May contain only the number of addresses or the actual addresses or both.",
+		Children: []string{"FAC", "NUM"},
+	},
+	"FAC": {
+		Keyword:  "FAC",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"FAC\" 1{ LIM_CHAR }30",
+		Semantic: "Address data.",
+		Parents:  []string{"ADDR", "ADDRINFO", "CASSADDR", "EXTADDR", "ORIGIN", "SPLADDR"},
+	},
+	"FANSLOGON": {
+		Keyword:  "FANSLOGON",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"FANSLOGON\" 2{appname appversion}2",
+		Semantic: "Logon parameters from FANS 1/A aircraft.",
+		Children: []string{"APPNAME", "APPVERSION"},
+	},
+	"FILRTE": {
+		Keyword:  "FILRTE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FILRTE\" {LIM_CHAR}",
+		Semantic: "The route exactly as filed i.e. without any processing.",
+	},
+	"FILTIM": {
+		Keyword:  "FILTIM",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FILTIM\" day ! timehhmm",
+		Semantic: "Day-time group specifying when the message was filed for transmission.",
+	},
+	"FIR": {
+		Keyword:  "FIR",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"FIR\" 7{ ALPHA }7",
+		Semantic: "Designates a FIR or UIR.",
+		Parents:  []string{"LFIR"},
+	},
+	"FL": {
+		Keyword:  "FL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \" FL\" flightlevel",
+		Semantic: "Agenericflightlevelfield. Maybea\"SFL\",\"EFL\",\"CFL\", \"RFL\", etc. depending on its context.",
+		Parents:  []string{"AD", "AFILDATA", "CFL", "ENTRYDATA", "ESTDATA", "FLBAND", "FLBLOCK", "PLANNEDPOSITION", "POSITION", "PT", "VEC"},
+	},
+	"FLBAND": {
+		Keyword:  "FLBAND",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"FLBAND\" fl fl",
+		Semantic: "A flight level band defining the airspace vertically, inclusive of the flight levels given.",
+		Children: []string{"FL"},
+	},
+	"FLBLOCK": {
+		Keyword:  "FLBLOCK",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"FLBLOCK\" fl fl",
+		Semantic: "Aflightlevelblockdefiningan airspace vertically, inclusive of the flight levels given. A block defined as below or above a flight level shall be expressed respectively as from flight level 000 to the specified level orasfromthespecifiedlevelto flight level 999.",
+		Children: []string{"FL"},
+		Parents:  []string{"AD", "AIRROUTE", "AIRSPACE", "EXCCOND", "PT", "REGULATION", "RRTEFROM", "RRTETO", "TFV"},
+	},
+	"FLOW": {
+		Keyword:  "FLOW",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"FLOW\" frompos [via1] [via2] topos [via3] [via4] flowrole",
+		Semantic: "Descriptionofa'flow'givingthe source area, optionally the routes or pointstobeoverflownfromthe sourcearea,thedestinationarea and optionally the routes or points to be overflown to the destination area.",
+		Children: []string{"FLOWROLE", "FROMPOS", "TOPOS", "VIA1", "VIA2", "VIA4"},
+		Parents:  []string{"FLOWLST"},
+	},
+	"FLOWLST": {
+		Keyword:  "FLOWLST",
+		Class:    Subfield,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"FLOWLST\"1{flow}'-' \"END\" \"FLOWLST\"",
+		Semantic: "List of traffic flows.",
+		Children: []string{"FLOW"},
+		Parents:  []string{"RRTEFROM", "RRTETO", "TFV"},
+	},
+	"FLOWROLE": {
+		Keyword:  "FLOWROLE",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"FLOWROLE\" 'EX' | 'IE' | 'EM' | 'IN'",
+		Semantic: "An indication of the 'role' of a flow. EX = excluded IE = included exempted EM = exempted IN = included",
+		Parents:  []string{"FLOW"},
+	},
+	"FLTRUL": {
+		Keyword:  "FLTRUL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FLTRUL\" flightrule",
+		Semantic: "Flight rule, as ICAO field 8.",
+	},
+	"FLTSTATE": {
+		Keyword:  "FLTSTATE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FLTSTATE\" atfmflightstate",
+		Semantic: "The ATFM status of a flight.",
+	},
+	"FLTTYP": {
+		Keyword:  "FLTTYP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FLTTYP\" flighttype",
+		Semantic: "Type of flight, as ICAO field 8.",
+	},
+	"FMPLIST": {
+		Keyword:  "FMPLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"FMPLIST\"fmpreglist '-' \"END\" \"FMPLIST\"",
+		Semantic: "List of FMPs and their associated ATFM regulations.",
+		Children: []string{"REGLIST"},
+	},
+	"FREQ": {
+		Keyword:  "FREQ",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FREQ\" rtf",
+		Semantic: "Radio frequency.",
+	},
+	"FROM": {
+		Keyword:  "FROM",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"FROM\" day!timehhmm",
+		Semantic: "The time from which a period of time begins.",
+		Parents:  []string{"RATEPERIOD", "RVRPERIOD"},
+	},
+	"FROMPOS": {
+		Keyword:  "FROMPOS",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"FROMPOS\" 1 {ALPHANUM} 15",
+		Semantic: "Apositionfromwhicharoute,a routeportion,a'path'oraflow begins. May be a region, an aerodrome or a significant point.",
+		Parents:  []string{"FLOW"},
+	},
+	"FSTDAY": {
+		Keyword:  "FSTDAY",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FSTDAY\" date",
+		Semantic: "First day of operation for a repetitive flight plan. This is used to give the actual first day from which flight plans will be generated from a RPL (see valfrom field) or the first day on which an amendment to an RPL is effective.",
+	},
+	"FURTHRTE": {
+		Keyword:  "FURTHRTE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"FURTHRTE\" {LIM_CHAR}",
+		Semantic: "Thefurtherroutingofaflight.Forusewithinmessages containing estimate data to indicate the further routing of the flight following the estimate point. It may contain only the nextpointorthecompletefurtherroutinguntilthe destination.",
+	},
+	"GEO": {
+		Keyword:  "GEO",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"GEO\" geoid lattd longtd",
+		Semantic: "Point along a route defined by latitude and longitude and given in the flight plan, as GEOxx (where xx is a sequence number).",
+		Children: []string{"GEOID", "LATTD", "LONGTD"},
+	},
+	"GEOID": {
+		Keyword:  "GEOID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"GEOID\" geoname",
+		Semantic: "Identifierofageographicalpoint madeof\"GEO\"followedbya sequencenumber (example: \"GEO12\").",
+		Parents:  []string{"ALTNZ", "DEPZ", "DESTZ", "GEO"},
+	},
+	"HEXADDR": {
+		Keyword:  "HEXADDR",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"HEXADDR\" (36{hexadecimal}36) | (38{hexadecimal}38)",
+		Semantic: "Hexadecimaladdresswhichmust contain either thirty six or thirty eight hexadecimal characters.",
+		Parents:  []string{"CMLTSP"},
+	},
+	"IFP": {
+		Keyword:  "IFP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IFP\" ifpvalue",
+		Semantic: "An indicator or flag used by IFPS to warn or to notify ATC units of additional information concerning a flight plan.",
+	},
+	"IFPDLIST": {
+		Keyword:  "IFPDLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"IFPDLIST\" 1 { ifpdlong } '-' \"END\" \"IFPDLIST\"",
+		Semantic: "List of complete IFPDs matching the database key given in a querymessage. Contains a list of complete information for each individual flight which matches given query keys.",
+		Children: []string{"IFPDLONG"},
+	},
+	"IFPDLONG": {
+		Keyword:  "IFPDLONG",
+		Class:    Subfield,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"IFPDLONG\" adexpmsg '-' \"END\" \"IFPDLONG\"",
+		Semantic: "Complete information concerning an individual flight plan.",
+		Parents:  []string{"IFPDLIST"},
+	},
+	"IFPDSLIST": {
+		Keyword:  "IFPDSLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"IFPDSLIST\" 1 { ifpdsum } '-' \"END\" \"IFPDSLIST\"",
+		Semantic: "List of ifpdsum matching the database key given in a query message. Contains a list of summarised information for each individual flight which matches given query keys.",
+		Children: []string{"IFPDSUM"},
+	},
+	"IFPDSUM": {
+		Keyword:  "IFPDSUM",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"IFPDSUM\" arcid adep ades eobt orgn",
+		Semantic: "Summary information concerning an individual flight plan. It contains the arcid,adep,ades,eobtandorgn fields.",
+		Children: []string{"ADEP", "ADES", "ARCID", "EOBT", "ORGN"},
+		Parents:  []string{"IFPDSLIST"},
+	},
+	"IFPLID": {
+		Keyword:  "IFPLID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IFPLID\" 2{ALPHA}2 ! 8{ DIGIT }8",
+		Semantic: "A unique flight plan identifier, assigned by the IFPS.",
+	},
+	"IFPSMOD": {
+		Keyword:  "IFPSMOD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IFPSMOD\" fieldid modifind",
+		Semantic: "An indication given by IFPS of those fields which have been modified, and the nature of the modification.",
+	},
+	"IFPURESP": {
+		Keyword:  "IFPURESP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IFPURESP\" ifpuid",
+		Semantic: "Identifier of the IFPU which is responsible for a query. It must process the query and answer to it.",
+	},
+	"IGNORE": {
+		Keyword:  "IGNORE",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"IGNORE\" { (condition | condition ptid ptid) }'-' \"END\" \"IGNORE\"",
+		Semantic: "Indication of conditions which have been 'ignored' or by- passed in the processing of the message concerned. An 'ignored' condition may be limited to a specific portion of the route delimited by the route points given. A condition may, for example, be a time restriction (route access condition), flight level restriction or TOS violation.",
+		Children: []string{"CONDITION", "PTID"},
+	},
+	"ILSCAT": {
+		Keyword:  "ILSCAT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"ILSCAT\" (\"I\" | \"II\" | \"IIIa\" | \"IIIb\" | \"NOILS\")",
+		Semantic: "The active status of ILS category (I, II, IIIa, IIIb) or ILS not available.",
+		Parents:  []string{"RWYINFO"},
+	},
+	"IOBD": {
+		Keyword:  "IOBD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IOBD\" date",
+		Semantic: "The 'Initial' Off Block Date - the 'off-block date' as given in the FPL and updated by flight plan associated messages (DLA,CHG,etc.).Thisisthereferencedateusedfor accessing the flight plan in the database and is the only 'off- blockdate'knownbytheconcernedATSunits. Note: The IOBD is not affected by changes requested or notified through the exchange of ATFM messages.",
+	},
+	"IOBT": {
+		Keyword:  "IOBT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IOBT\" timehhmm",
+		Semantic: "The 'Initial' Off Block Time - the 'off-block time' as given in the FPL and updated by flight plan associated messages (DLA,CHG,etc.).Thisisthereferencetimeusedfor accessing the flight plan in the database and is the only 'off- blocktime'knownbytheconcernedATSunits. Note: The IOBT is not affected by changes requested or notified through the exchange of ATFM messages.",
+	},
+	"IRULES": {
+		Keyword:  "IRULES",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"IRULES\" rulechg flighttypechg ifpsprocess",
+		Semantic: "Contains the initial flight rules, initial flight type and initial IFPS processing.",
+	},
+	"LACDR": {
+		Keyword:  "LACDR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"LACDR\"{airroute}'-'\"END\" \"LACDR\"",
+		Semantic: "List of Active Conditional Routes.",
+		Children: []string{"AIRROUTE"},
+		Parents:  []string{"LFIR"},
+	},
+	"LASTNUM": {
+		Keyword:  "LASTNUM",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"LASTNUM\" 3{DIGIT}3",
+		Semantic: "A three digit number indicating the end of a sequence.",
+	},
+	"LATSA": {
+		Keyword:  "LATSA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"LATSA\"{airspace}'-'\"END\" \"LATSA\"",
+		Semantic: "List of Active Temporary Segregated Areas.",
+		Children: []string{"AIRSPACE"},
+		Parents:  []string{"LFIR"},
+	},
+	"LATTD": {
+		Keyword:  "LATTD",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"LATTD\" latitudelong ! latitudeside",
+		Semantic: "Latitudeindegrees,minutes, secondsanddirection(Northor South).",
+		Parents:  []string{"EETLAT", "GEO"},
+	},
+	"LCATSRTE": {
+		Keyword:  "LCATSRTE",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"LCATSRTE\" { airroute } '-' \"END\" \"LCATSRTE\"",
+		Semantic: "List of Closed ATS Routes.",
+		Children: []string{"AIRROUTE"},
+		Parents:  []string{"LFIR"},
+	},
+	"LFIR": {
+		Keyword:  "LFIR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"LFIR\" 1{ fir ( lacdr | ( lacdr lcatsrte latsa lrar lrca) ) } '-' \"END\" \"LFIR\"",
+		Semantic: "List of FIRs, including the name of the region followed by either the list of Available Conditional Routes or the lists of Available Conditional Routes, Closed ATS Routes, Active TemporarySegregatedAreas,ReducedAirspace Restrictions and Reduced Co-ordination Airspaces.",
+		Children: []string{"FIR", "LACDR", "LATSA", "LCATSRTE", "LRAR"},
+	},
+	"LONGTD": {
+		Keyword:  "LONGTD",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-'\"LONGTD\"longitudelong! longitudeside",
+		Semantic: "Longitudeindegrees,minutes, secondsanddirection(Eastor West).",
+		Parents:  []string{"EETLONG", "GEO"},
+	},
+	"LRAR": {
+		Keyword:  "LRAR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"LRAR\" { airspace } '-' \"END\" \"LRAR\"",
+		Semantic: "List of Reduced Airspace Restrictions.",
+		Children: []string{"AIRSPACE"},
+		Parents:  []string{"LFIR"},
+	},
+	"LSTDAY": {
+		Keyword:  "LSTDAY",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"LSTDAY\" date",
+		Semantic: "Last day of operation for a repetitive flight plan. This is used to give the actual last day from which flight plans will be generated from a RPL (see valuntil field) or the last day on whichanamendmenttoanRPLiseffective => Must be a date between VALFROM and VALUNTIL.",
+	},
+	"MACH": {
+		Keyword:  "MACH",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MACH\" machnumber [ point ]",
+		Semantic: "Mach number, in hundredths of a unit and optionally the point at which the change is requested.",
+	},
+	"MESVALPERIOD": {
+		Keyword:  "MESVALPERIOD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MESVALPERIOD\" fulldatetime fulldatetime",
+		Semantic: "Thevalidityperiodofamessage,inclusiveofthetimes given.",
+	},
+	"MFX": {
+		Keyword:  "MFX",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MFX\" point",
+		Semantic: "The identifier of the metering fix.",
+	},
+	"MINLINEUP": {
+		Keyword:  "MINLINEUP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MINLINEUP\" timehhmm",
+		Semantic: "The minimum time required for a flight, which has declared itself ready to depart, to get from it's present holding position to airborne.",
+	},
+	"MODELTYP": {
+		Keyword:  "MODELTYP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MODELTYP\" atfmmodeltype",
+		Semantic: "The type of flight model included in the message.",
+	},
+	"MODIFNB": {
+		Keyword:  "MODIFNB",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MODIFNB\" 1{ DIGIT }3",
+		Semantic: "Number of modifications that were necessary to correct an original message.",
+	},
+	"MSGREF": {
+		Keyword:  "MSGREF",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"MSGREF\" sender recvr seqnum",
+		Semantic: "Referencedataforassociated,previouslytransmitted messages.",
+		Children: []string{"RECVR", "SENDER", "SEQNUM"},
+	},
+	"MSGSUM": {
+		Keyword:  "MSGSUM",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"MSGSUM\" { [arcid] [adep] [ades] [eobt] [eobd] [orgn] [days] [valfrom] [valuntil] } '-' \"END\" MSGSUM\"",
+		Semantic: "Containsasummaryofamessage. Note: Must contain one or more* of the fields arcid, adep, ades,eobtandorgnbutwithoutrepetition. *oneormoreofthefieldsmayhavebeenmissingor garbled in received message",
+		Children: []string{"ADEP", "ADES", "ARCID", "DAYS", "EOBD", "EOBT", "ORGN", "VALFROM", "VALUNTIL"},
+	},
+	"MSGTXT": {
+		Keyword:  "MSGTXT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MSGTXT\" icaomsg",
+		Semantic: "Contains a complete ICAO message.",
+	},
+	"MSGTYP": {
+		Keyword:  "MSGTYP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"MSGTYP\" titleid",
+		Semantic: "Containsthetitleofthereferencedorcopiedmessage. May be any valid ADEXP message title (see Annex B).",
+	},
+	"NAV": {
+		Keyword:  "NAV",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NAV\" 1 {LIM_CHAR} 50",
+		Semantic: "As ICAO field 18 NAV/.",
+	},
+	"NBARC": {
+		Keyword:  "NBARC",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NBARC\" 1{ DIGIT }2",
+		Semantic: "Number of aircraft if more than one.",
+	},
+	"NBRFPD": {
+		Keyword:  "NBRFPD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NBRFPD\" 1{ DIGIT }3",
+		Semantic: "Numberofflightplandatamatchingaquery. Must be between 0 and 999.",
+	},
+	"NETWORKTYPE": {
+		Keyword:  "NETWORKTYPE",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-'\"NETWORKTYPE\" 2{ALPHANUM}10",
+		Semantic: "Indicationofthetypeofnetwork used for a message exchange.",
+		Parents:  []string{"ADDRINFO", "ORIGIN"},
+	},
+	"NEWCTOT": {
+		Keyword:  "NEWCTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWCTOT\" timehhmm",
+		Semantic: "A new Calculated Take-Off Time, as updated by ETFMS.",
+	},
+	"NEWENDTIME": {
+		Keyword:  "NEWENDTIME",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWENDTIME\" day ! timehhmm",
+		Semantic: "A new time at which a period of time ends.",
+	},
+	"NEWEOBD": {
+		Keyword:  "NEWEOBD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWEOBD\" date",
+		Semantic: "A new Estimated Off-Block Date.",
+	},
+	"NEWEOBT": {
+		Keyword:  "NEWEOBT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWEOBT\" timehhmm",
+		Semantic: "A new Estimated Off-Block Time.",
+	},
+	"NEWPTOT": {
+		Keyword:  "NEWPTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWPTOT\" timehhmm",
+		Semantic: "A new Provisional Take-Off Time.",
+	},
+	"NEWRTE": {
+		Keyword:  "NEWRTE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWRTE\" { LIM_CHAR }",
+		Semantic: "A new route between the same aerodromes of departure and arrival as in the original message.",
+	},
+	"NEWSTARTTIME": {
+		Keyword:  "NEWSTARTTIME",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEWSTARTTIME\" day ! timehhmm",
+		Semantic: "A new time at which a period of time starts.",
+	},
+	"NEXTSSRCODE": {
+		Keyword:  "NEXTSSRCODE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"NEXTSSRCODE\" 'A' ! 4{'0' | '1' | '2' | '3' | '4' | '5' | '6' | '7'}4",
+		Semantic: "SSR Mode and Code to be used by the flight after the SSR Mode and Code given in field 'SSRCODE'.",
+	},
+	"NUM": {
+		Keyword:  "NUM",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"NUM\" 3{DIGIT}3",
+		Semantic: "A three digit number.",
+		Parents:  []string{"AIRROUTE", "AIRSPACE", "EXTADDR", "SEQUENCEDATA"},
+	},
+	"OLDMSG": {
+		Keyword:  "OLDMSG",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"OLDMSG\" { CHARACTER }",
+		Semantic: "Acompleteoriginalmessage,exactly(andinthesame format) as it was received.",
+	},
+	"OPR": {
+		Keyword:  "OPR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"OPR\" 1 { LIM_CHAR }",
+		Semantic: "Name of the company or agency operating the flight, as ICAO Field 18 element OPR/.",
+	},
+	"ORGMSG": {
+		Keyword:  "ORGMSG",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ORGMSG\" titleid",
+		Semantic: "TheADEXPTitleofanerroneousmessage,asitwas received.",
+	},
+	"ORGN": {
+		Keyword:  "ORGN",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ORGN\" 1{LIM_CHAR}30",
+		Semantic: "The address of the originator of a message.",
+		Parents:  []string{"IFPDSUM", "MSGSUM", "RFPDSUM"},
+	},
+	"ORGNID": {
+		Keyword:  "ORGNID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ORGNID\" originatorid",
+		Semantic: "Thedesignatorofanaddresseehavingoriginateda message.",
+	},
+	"ORGRTE": {
+		Keyword:  "ORGRTE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ORGRTE\" { LIM_CHAR }",
+		Semantic: "Originalroutebetweenthe aerodromesofdepartureand arrival.",
+	},
+	"ORIGIN": {
+		Keyword:  "ORIGIN",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-'\"ORIGIN\"networktype|fac|(networktype fac)",
+		Semantic: "Information concerning the originator of a message. May include the type of network used or the address concerned or both.",
+		Children: []string{"FAC", "NETWORKTYPE"},
+	},
+	"ORIGINDT": {
+		Keyword:  "ORIGINDT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ORIGINDT\" datetime",
+		Semantic: "Date and time of receipt of original message by the IFPS. Note:Thisisnotthefilingtimeofthemessage. Format is YYMMDDHHMM.",
+	},
+	"PBN": {
+		Keyword:  "PBN",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"PBN\" pbncode",
+		Semantic: "As in ICAO Field 18 PBN/. Used to indicate RNAV and/or performance based navigation capabilities.",
+	},
+	"PENRATE": {
+		Keyword:  "PENRATE",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PENRATE\" 3{LIM_CHAR}7",
+		Semantic: "The \"pending rate\", used for ATFM purposes.",
+		Parents:  []string{"RATEPERIOD"},
+	},
+	"PER": {
+		Keyword:  "PER",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"PER\" performancecategory",
+		Semantic: "Aircraft performance category, as ICAO field 18 PER/.",
+	},
+	"PLANNEDPOSITION": {
+		Keyword:  "PLANNEDPOSITION",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"PLANNEDPOSITION\" (adid | ptid) (to | cto | sto | (to cto) ) [fl]",
+		Semantic: "The planned position of an aircraft given as either a point or an aerodrome with time and optional flight level information.",
+		Children: []string{"ADID", "CTO", "FL", "PTID", "STO", "TO"},
+	},
+	"PNTSECTOR": {
+		Keyword:  "PNTSECTOR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"PNTSECTOR\" 1{ALPHANUM}8",
+		Semantic: "Identifierofthesectorpointedtobythetransferring controller.",
+	},
+	"POSITION": {
+		Keyword:  "POSITION",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"POSITION\" (adid | ptid)[(to | sto)] [fl] [cto]",
+		Semantic: "The position of an aircraft given as either a point or an aerodrome with optional time and flight level information.",
+		Children: []string{"ADID", "CTO", "FL", "PTID", "STO", "TO"},
+	},
+	"POSTPROCTXT": {
+		Keyword:  "POSTPROCTXT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"POSTPROCTXT\" adexpmsg",
+		Semantic: "ContainsacompleteADEXP messageafterithasbeen processed.",
+		Parents:  []string{"ADEXPTXT"},
+	},
+	"PREPROCTXT": {
+		Keyword:  "PREPROCTXT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PREPROCTXT\" adexpmsg",
+		Semantic: "ContainsacompleteADEXP message prior to it being processed i.e. as it was received.",
+		Parents:  []string{"ADEXPTXT"},
+	},
+	"PREVARCID": {
+		Keyword:  "PREVARCID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"PREVARCID\" aircraftid",
+		Semantic: "The previous callsign used.",
+	},
+	"PREVSSRCODE": {
+		Keyword:  "PREVSSRCODE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"PREVSSRCODE\" 'A' ! 4{ '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' }4",
+		Semantic: "SSR Mode and Code used by the flight immediately prior to the SSR Mode and Code given in field '-SSRCODE'.",
+	},
+	"PROPFL": {
+		Keyword:  "PROPFL",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"PROPFL\" tfl [sfl]",
+		Semantic: "A flight level proposed by an accepting unit for the transfer of a flight.",
+		Children: []string{"SFL", "TFL"},
+	},
+	"PT": {
+		Keyword:  "PT",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"PT\" ptid [(fl | flblock)] [eto] [to] [cto] [sto] [sfl] [ptstay] [ptrfl] [ptrulchg] [(ptspeed | ptmach)] [ptrte] [ptcrsclimb]",
+		Semantic: "A point of the route of a flight, with its associated information.",
+		Children: []string{"CTO", "ETO", "FL", "FLBLOCK", "PTCRSCLIMB", "PTID", "PTMACH", "PTRFL", "PTRTE", "PTRULCHG", "PTSPEED", "PTSTAY", "SFL", "STO", "TO"},
+		Parents:  []string{"RTEPTS"},
+	},
+	"PTCRSCLIMB": {
+		Keyword:  "PTCRSCLIMB",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"PTCRSCLIMB\" (crspeed | crmach) crfl1 crfl2",
+		Semantic: "Indication in the route of a flight of a cruiseclimb.Givingthespeedor mach no. followed by the two levels indicating the flight level band to be occupiedduringtheclimb.The second level may be \"PLUS\" where the upper level is unknown.",
+		Children: []string{"CRFL1", "CRFL2", "CRMACH", "CRSPEED"},
+		Parents:  []string{"PT"},
+	},
+	"PTFLTRUL": {
+		Keyword:  "PTFLTRUL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTFLTRUL\" 'VFR' | 'IFR'",
+		Semantic: "An indication of the flight rules which areapplicableatthepoint concerned.",
+		Parents:  []string{"ENTRYDATA"},
+	},
+	"PTID": {
+		Keyword:  "PTID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTID\" point",
+		Semantic: "Pointidentification,eithercoded designatororanamegiven artificially(GEOxx,REFxxor RENxx).",
+		Parents:  []string{"AFILDATA", "ALTNZ", "CFL", "COORDATA", "CRSCLIMB", "DEPZ", "DESTZ", "ENTRYDATA", "ESTDATA", "IGNORE", "PLANNEDPOSITION", "POSITION", "PT", "REF", "STAY"},
+	},
+	"PTMACH": {
+		Keyword:  "PTMACH",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTMACH\" machnumber",
+		Semantic: "Machnumber,inhundredthsofa unit,associatedtoapointonthe route.",
+		Parents:  []string{"AD", "ENTRYDATA", "PT"},
+	},
+	"PTMILRUL": {
+		Keyword:  "PTMILRUL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTMILRUL\" 'OAT' | 'GAT'",
+		Semantic: "Anindicationof the'military'flight ruleswhichareapplicableatthe point concerned.",
+		Parents:  []string{"ENTRYDATA"},
+	},
+	"PTOT": {
+		Keyword:  "PTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTOT\" timehhmm",
+		Semantic: "Provisional Take-Off Time. Provisional reference time for an ATFM slot.",
+	},
+	"PTRFL": {
+		Keyword:  "PTRFL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTRFL\" flightlevel",
+		Semantic: "Requested flight level, associated to a point on the route.",
+		Parents:  []string{"AD", "ENTRYDATA", "PT", "STAY"},
+	},
+	"PTRTE": {
+		Keyword:  "PTRTE",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTRTE\" 2{LIM_CHAR}",
+		Semantic: "The route of flight following the point indicated.Maybethecomplete route to the destination aerodrome or simply the routing element to the next point.",
+		Parents:  []string{"PT"},
+	},
+	"PTRULCHG": {
+		Keyword:  "PTRULCHG",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-'\"PTRULCHG\"1{rulechg flighttypechg ifpsprocess}3",
+		Semantic: "Indicationofachangeinoneor more of \"flight rules\"(VFR/IFR), the \"typeofflight\"(OAT/GAT),and/or the ifpsprocess (Stop/Start)",
+		Parents:  []string{"AD", "PT"},
+	},
+	"PTSPEED": {
+		Keyword:  "PTSPEED",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTSPEED\" spd",
+		Semantic: "Trueairspeed(inkilometresper hours or knots) associated to a point on the route.",
+		Parents:  []string{"AD", "ENTRYDATA", "PT", "STAY"},
+	},
+	"PTSTAY": {
+		Keyword:  "PTSTAY",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"PTSTAY\" stayidentifier timehhmm",
+		Semantic: "Indicationwithinthefiledrouteof flight of a period of 'special activity' whentheaircraftwill'stay'inthe area defined for the length of time given,i.e.training,mid-airre- fuelling, etc.",
+		Parents:  []string{"AD", "PT"},
+	},
+	"QRORGN": {
+		Keyword:  "QRORGN",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"QRORGN\" originatorid",
+		Semantic: "Identifier of the originator of the Query.",
+	},
+	"RALT": {
+		Keyword:  "RALT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RALT\" (1 {LIM_CHAR} 100",
+		Semantic: "As in ICAO Field 18 RALT/. An indication of the en-route alternate.",
+	},
+	"RATE": {
+		Keyword:  "RATE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RATE\" (((\"C\" | \"D\") ! (2{DIGIT}2 | \"ZZZ\")) | \"ZZZ\" )",
+		Semantic: "Rate of change: the climb or descent rate assigned to an aircraft,expressedinhundredsoffeetperminute. => Must be 'C' indicating a climb rate, or 'D' indicating a descent rate, followed by a two digit number indicating the assigned rate in hundreds of feet per minute. Alternatively the designator 'ZZZ' may be used to indicate that there is no assigned rate of climb or descent. 'C' or 'D' followed by 'ZZZ' canbeusedtoindicatethataflightisclimbingor descending with an unknown rate.",
+	},
+	"RATELIMIT": {
+		Keyword:  "RATELIMIT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RATELIMIT\" 1{ \"MIN\" | \"EQL\" | \"MAX\" }1",
+		Semantic: "Indication of a minimum, fixed or maximum value for a rate of climb/descent.",
+	},
+	"RATEPDLST": {
+		Keyword:  "RATEPDLST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"RATEPDLST\"1{rateperiod}'-' \"END\" \"RATEPDLST\"",
+		Semantic: "List of time periods and their respective flow rates for an ATFM condition.",
+		Children: []string{"RATEPERIOD"},
+	},
+	"RATEPERIOD": {
+		Keyword:  "RATEPERIOD",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"RATEPERIOD\" from until flowrate penrate",
+		Semantic: "A period of time during which the given flow rates are applicable for an ATFM Regulation.",
+		Children: []string{"FROM", "PENRATE", "UNTIL"},
+		Parents:  []string{"RATEPDLST", "REGCOND"},
+	},
+	"RDYSTATE": {
+		Keyword:  "RDYSTATE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RDYSTATE\" readyforimpr ! atfmrdystate",
+		Semantic: "The ready status of a flight.",
+	},
+	"REASON": {
+		Keyword:  "REASON",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"REASON\" 4{ALPHA}12",
+		Semantic: "Informationinsupportofthemessagedependentonits context.",
+	},
+	"RECVR": {
+		Keyword:  "RECVR",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"RECVR\" fac",
+		Semantic: "Thereceiverofthereferenced message.",
+		Parents:  []string{"MSGREF", "REFDATA"},
+	},
+	"REF": {
+		Keyword:  "REF",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"REF\" refid ptid brng distnc",
+		Semantic: "Point along a route which is defined in terms of magnetic bearing and distance from another point and is given the designator REFxx.",
+		Children: []string{"BRNG", "DISTNC", "PTID", "REFID"},
+	},
+	"REFATSRTE": {
+		Keyword:  "REFATSRTE",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-'\"REFATSRTE\"atsroutepoint [country] point [country]",
+		Semantic: "ATS route designator and identifiers of first and last points. The points listedmaybeICAOidentifiersor artificially given GEOxx, RENxxor REFxx points. The identifier of the countrywithinwhichthepointis located may optionally be included. The end points must be consistent with the route information.",
+		Parents:  []string{"AIRROUTE"},
+	},
+	"REFDATA": {
+		Keyword:  "REFDATA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"REFDATA\" [sender] [recvr] seqnum",
+		Semantic: "Reference data for message being transmitted.",
+		Children: []string{"RECVR", "SENDER", "SEQNUM"},
+	},
+	"REFID": {
+		Keyword:  "REFID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"REFID\" refname",
+		Semantic: "Identifier of a reference point.",
+		Parents:  []string{"ALTNZ", "DEPZ", "DESTZ", "REF"},
+	},
+	"REFLOC": {
+		Keyword:  "REFLOC",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"REFLOC\" 1{LIM_CHAR}15",
+		Semantic: "ReferencelocationofanATFM Regulation.",
+		Parents:  []string{"REGULATION", "RRTEFROM", "RRTETO", "TFV"},
+	},
+	"REG": {
+		Keyword:  "REG",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"REG\" 1{ LIM_CHAR }50",
+		Semantic: "Registration markings, as ICAO field 18 REG/. In the case of aformationflightmorethanoneregistrationmaybe provided.",
+	},
+	"REGCAUSE": {
+		Keyword:  "REGCAUSE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-'\"REGCAUSE\"regulationreason iatalocationcat iatadelaycode",
+		Semantic: "TheCFMUandIATAcodeddesignatorsindicatingthe reason for a regulation.",
+	},
+	"REGCOND": {
+		Keyword:  "REGCOND",
+		Class:    Subfield,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"REGCOND\" {rateperiod} '-' \"END\" \"REGCOND\"",
+		Semantic: "Listoftimeperiodsandtheir respective flow rates for a particular regulation.",
+		Children: []string{"RATEPERIOD"},
+		Parents:  []string{"REGULATION"},
+	},
+	"REGDESC": {
+		Keyword:  "REGDESC",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"REGDESC\" 1{LIM_CHAR}",
+		Semantic: "Description of an ATFM Regulation.",
+		Parents:  []string{"REGULATION"},
+	},
+	"REGID": {
+		Keyword:  "REGID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"REGID\" regulid",
+		Semantic: "Identification of a flow management \"Regulation\".",
+		Parents:  []string{"REGULATION"},
+	},
+	"REGLIST": {
+		Keyword:  "REGLIST",
+		Class:    Subfield,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"REGLIST\"regulation [exccond] '-' \"END\" \"REGLIST\"",
+		Semantic: "Listof\"Regulations\"forflow management purposes.",
+		Children: []string{"EXCCOND", "REGULATION"},
+		Parents:  []string{"FMPLIST"},
+	},
+	"REGLOC": {
+		Keyword:  "REGLOC",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"REGLOC\" 1 {LIM_CHAR} 15",
+		Semantic: "Reference location for an ATFM Regulation.",
+	},
+	"REGNUM": {
+		Keyword:  "REGNUM",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-'\"REGNUM\"3{DIGIT}3!\"/\"! 2{DIGIT}2",
+		Semantic: "AreferencenumberforanATFM \"Regulation\".Providesaunique referencefollowedbyavalidity indication.",
+	},
+	"REGREASON": {
+		Keyword:  "REGREASON",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"REGREASON\" 4 {ALPHA} 12",
+		Semantic: "ThereasonforanATFM Regulation.",
+		Parents:  []string{"REGULATION"},
+	},
+	"REGUL": {
+		Keyword:  "REGUL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"REGUL\" regulid",
+		Semantic: "Identifier of a Regulation concerning a flight.",
+		Parents:  []string{"AFREGULLIST"},
+	},
+	"REGULATION": {
+		Keyword:  "REGULATION",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-'\"REGULATION\"regnumregid regdesc refloc startreg endreg [flblock] [remark] [tfvid] [regreason] [regcond]",
+		Semantic: "A\"Regulation\"imposedforflow management purposes.",
+		Children: []string{"ENDREG", "FLBLOCK", "REFLOC", "REGCOND", "REGDESC", "REGID", "REGREASON", "REMARK", "TFVID"},
+		Parents:  []string{"REGLIST"},
+	},
+	"REJCTOT": {
+		Keyword:  "REJCTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"REJCTOT\" timehhmm",
+		Semantic: "Rejected Calculated Take-Off Time: negative response to a Slot Improvement Proposal.",
+	},
+	"RELDIST": {
+		Keyword:  "RELDIST",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RELDIST\" 2{DIGIT}2",
+		Semantic: "Thepercentageofthedistancealongaroutesegment between 2 route points.",
+		Parents:  []string{"VEC"},
+	},
+	"RELEASE": {
+		Keyword:  "RELEASE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RELEASE\" 1{ALPHA}1",
+		Semantic: "An indication that the flight is released by the transferring controller to the receiving controller. C = released for climb D = released for descent T = released for turns F = released for all actions",
+	},
+	"REMARK": {
+		Keyword:  "REMARK",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"REMARK\" 1{LIM_CHAR}",
+		Semantic: "Aremarkabouttheitem,the description of which this field is a part.",
+		Parents:  []string{"AIRROUTE", "AIRSPACE", "EXCCOND", "REGULATION", "STAYINFO"},
+	},
+	"RENID": {
+		Keyword:  "RENID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"RENID\" renameid",
+		Semantic: "Identifier given to a point which is repeated in the route description.",
+	},
+	"RESPBY": {
+		Keyword:  "RESPBY",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RESPBY\" timehhmm",
+		Semantic: "RespondBy:timebywhicharesponsetoaSlot Improvement Proposal has to be made.",
+	},
+	"RESPUNIT": {
+		Keyword:  "RESPUNIT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"RESPUNIT\" 3{ALPHA}12",
+		Semantic: "The responsible ATC Unit.",
+		Parents:  []string{"AIRSPACE"},
+	},
+	"RFL": {
+		Keyword:  "RFL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RFL\" flightlevel [point]",
+		Semantic: "Requested flight level (in flight level number, tens of meters orhundredsoffeet)andoptionallythepointatwhicha change of RFL is required.",
+	},
+	"RFP": {
+		Keyword:  "RFP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RFP\" \"Q\" ( '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' )",
+		Semantic: "ReplacementFlightPlan(RFP)indicator. Must be \"Q\" followed by a digit (1 - 9).",
+	},
+	"RFPDLIST": {
+		Keyword:  "RFPDLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"RFPDLIST\" { rfpdlong } '-' \"END\" \"RFPDLIST\"",
+		Semantic: "List of complete RFPDs matching the database keys given in a Query.",
+		Children: []string{"RFPDLONG"},
+	},
+	"RFPDLONG": {
+		Keyword:  "RFPDLONG",
+		Class:    Subfield,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"RFPDLONG\" {adexpmsg} '-' \"END\" \"RFPDLONG\"",
+		Semantic: "Complete information concerning a repetitive flight plan.",
+		Parents:  []string{"RFPDLIST"},
+	},
+	"RFPDSLIST": {
+		Keyword:  "RFPDSLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"RFPDSLIST\" { rfpdsum } '-' \"END\" \"RFPDSLIST\"",
+		Semantic: "List of rfpdsum (RFPD summarised information) matching the database keys given in a Query.",
+		Children: []string{"RFPDSUM"},
+	},
+	"RFPDSUM": {
+		Keyword:  "RFPDSUM",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"RFPDSUM\" arcid adep ades eobt orgn days valfrom valuntil",
+		Semantic: "Summaryoftheinformation concerning a repetitive flight plan. It contains the arcid, adep, ades, eobt, orgn,days,valfromandvaluntil fields.",
+		Children: []string{"ADEP", "ADES", "ARCID", "DAYS", "EOBT", "ORGN", "VALFROM", "VALUNTIL"},
+		Parents:  []string{"RFPDSLIST"},
+	},
+	"RIF": {
+		Keyword:  "RIF",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RIF 4{LIM_CHAR}",
+		Semantic: "Revised route subject to clearance in flight and terminating withtheICAOdesignatoroftherevisedaerodromeof destination.",
+	},
+	"RMK": {
+		Keyword:  "RMK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RMK\" 1{ LIM_CHAR }",
+		Semantic: "Plain language remarks, as ICAO field 18 RMK/.",
+	},
+	"ROUTE": {
+		Keyword:  "ROUTE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"ROUTE\" {LIM_CHAR}",
+		Semantic: "Complete ICAO Field 15 information containing speed, RFL and route (conforming to the syntax given in Ref. 5).",
+	},
+	"RRTEFROM": {
+		Keyword:  "RRTEFROM",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"RRTEFROM\" tfvid refloc flowlst flblock",
+		Semantic: "Description of a traffic flow which is to be re-routed.",
+		Children: []string{"FLBLOCK", "FLOWLST", "REFLOC", "TFVID"},
+	},
+	"RRTEREF": {
+		Keyword:  "RRTEREF",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RRTEREF\" rrteid",
+		Semantic: "Re-Route Reference.",
+	},
+	"RRTETO": {
+		Keyword:  "RRTETO",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"RRTETO\" tfvid refloc flowlst flblock",
+		Semantic: "Description of a traffic flow to which traffic is to be re-routed.",
+		Children: []string{"FLBLOCK", "FLOWLST", "REFLOC", "TFVID"},
+	},
+	"RTEPTS": {
+		Keyword:  "RTEPTS",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"RTEPTS\" { pt I ad | vec} '-' \"END\" \"RTEPTS\"",
+		Semantic: "Listofroutepoints.Mayalsocontainanaerodrome identifier.",
+		Children: []string{"AD", "PT", "VEC"},
+	},
+	"RVR": {
+		Keyword:  "RVR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RVR\" 1{ DIGIT }3",
+		Semantic: "RunwayVisualRange(RVR). Operatingminimawhenspecialmeteorological conditions exist. Expressed in meters.",
+	},
+	"RVRCOND": {
+		Keyword:  "RVRCOND",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\" \"RVRCOND\" 1 {rvrperiod} '-' \"END\" \"RVRCOND\"",
+		Semantic: "List of time periods and their applicable RVR limits.",
+		Children: []string{"RVRPERIOD"},
+	},
+	"RVRLIMIT": {
+		Keyword:  "RVRLIMIT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"RVRLIMIT\" 3{DIGIT}3",
+		Semantic: "RunwayVisualRange:operating minima when special meteorological conditionsexist.Expressedin meters.",
+		Parents:  []string{"EXCCOND", "RVRPERIOD"},
+	},
+	"RVRPERIOD": {
+		Keyword:  "RVRPERIOD",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"RVRPERIOD\" from until rvrlimit",
+		Semantic: "The period of time within which the RVR limit provided is applicable.",
+		Children: []string{"FROM", "RVRLIMIT", "UNTIL"},
+		Parents:  []string{"RVRCOND"},
+	},
+	"RWYARR": {
+		Keyword:  "RWYARR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RWYARR\" 2{DIGIT}2 [1{ 'L' | 'C' | 'R'}2]",
+		Semantic: "Arrival Runway.",
+	},
+	"RWYAVAIL": {
+		Keyword:  "RWYAVAIL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"RWYAVAIL\" ('D' | 'A' | 'C' | 'B')",
+		Semantic: "Availability of the runway: D: open for departures A: open for arrivals C: closed B: open for departures and arrivals",
+		Parents:  []string{"RWYINFO"},
+	},
+	"RWYDEP": {
+		Keyword:  "RWYDEP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"RWYDEP\" 2{DIGIT}2 [1{ 'L' | 'C' | 'R'}2]",
+		Semantic: "Departure Runway.",
+	},
+	"RWYID": {
+		Keyword:  "RWYID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"RWYID\" 2 { DIGIT } 2 ! ('L' | 'R' | 'C')",
+		Semantic: "Runway identifier",
+		Parents:  []string{"RWYINFO"},
+	},
+	"RWYINFO": {
+		Keyword:  "RWYINFO",
+		Class:    Subfield,
+		Kind:     Compound,
+		Syntax:   "'-' \"RWYINFO\" rwyid rwyavail [ilscat]",
+		Semantic: "Containsconfigurationdatafora specific runway",
+		Children: []string{"ILSCAT", "RWYAVAIL", "RWYID"},
+		Parents:  []string{"RWYLIST"},
+	},
+	"RWYLIST": {
+		Keyword:  "RWYLIST",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"RWYLIST\"{rwyinfo}'-'\"END\" \"RWYLIST\"",
+		Semantic: "Listofrunwaydatausedforrunwayconfigurations exchange.",
+		Children: []string{"RWYINFO"},
+	},
+	"SECTOR": {
+		Keyword:  "SECTOR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SECTOR\" 1{ ALPHANUM }8",
+		Semantic: "Identification of an ATC sector.",
+	},
+	"SEL": {
+		Keyword:  "SEL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SEL\" 4{ ALPHA }5",
+		Semantic: "SELCAL code as ICAO Feld 18 element 'SEL/'.",
+	},
+	"SENDER": {
+		Keyword:  "SENDER",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"SENDER\" fac",
+		Semantic: "Thesenderofthereferenced message.",
+		Parents:  []string{"MSGREF", "REFDATA"},
+	},
+	"SENDTO": {
+		Keyword:  "SENDTO",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-' \"BEGIN\"\"SENDTO\" {unit} '-' \"END\"\"SENDTO\"",
+		Semantic: "List of air navigation units which are to be sent a message",
+	},
+	"SEQNUM": {
+		Keyword:  "SEQNUM",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"SEQNUM\" 3{DIGIT}3",
+		Semantic: "The serial number of the message being sent (a 3 digit number unique to the sender/receiver combination).",
+		Parents:  []string{"MSGREF", "REFDATA"},
+	},
+	"SEQPT": {
+		Keyword:  "SEQPT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SEQPT\" surequipment",
+		Semantic: "Surveillance equipment and capabilities, as ICAO Field 10b.",
+	},
+	"SEQUENCEDATA": {
+		Keyword:  "SEQUENCEDATA",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"SEQUENCEDATA\" txtime num",
+		Semantic: "Sequence data of a message in order to be able to re-build the original transmission sequence of messages.",
+		Children: []string{"NUM", "TXTIME"},
+	},
+	"SEVERITY": {
+		Keyword:  "SEVERITY",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SEVERITY\" 1{ LIM_CHAR}",
+		Semantic: "To provide a severity indication",
+	},
+	"SFL": {
+		Keyword:  "SFL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' SFL flightlevel ! ('A'|'B')",
+		Semantic: "Supplementary flight level. The flight levelatorabovewhichor,ator below which a flight has been or will be co-ordinated to cross one point. Consists of a flight level number and a crossing condition (either 'A' if the aircraftwillcrossthepointator above the level, or 'B' if the aircraft will cross the point at or below the level).",
+		Parents:  []string{"CFL", "COORDATA", "ESTDATA", "PROPFL", "PT"},
+	},
+	"SID": {
+		Keyword:  "SID",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SID\" point ! 1{DIGIT}1 ! 0{ALPHA}1",
+		Semantic: "Identifier of a Specification Instrument Departure procedure.",
+	},
+	"SOBD": {
+		Keyword:  "SOBD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SOBD\" date",
+		Semantic: "Scheduled Off-Block Date of a flight",
+	},
+	"SOBT": {
+		Keyword:  "SOBT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SOBT\" timehhmm",
+		Semantic: "Scheduled Off-Block Time of a flight",
+	},
+	"SPEED": {
+		Keyword:  "SPEED",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPEED\" spd [ point ]",
+		Semantic: "Trueairspeed(inkilometresperhoursorknots)and optionally,thepointatwhichachangeofairspeedis requested.",
+	},
+	"SPEEDLIMIT": {
+		Keyword:  "SPEEDLIMIT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPEEDLIMIT\" 1{ \"MIN\" | \"EQL\" | \"MAX\" }1",
+		Semantic: "Indication of a minimum, fixed or maximum value for an assigned speed.",
+	},
+	"SPLA": {
+		Keyword:  "SPLA",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLA\" 1{ LIM_CHAR }50",
+		Semantic: "Colour of markings on aircraft, as ICAO Field 19 element 'A/'.",
+	},
+	"SPLADDR": {
+		Keyword:  "SPLADDR",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		List:     true,
+		Syntax:   "'-'\"BEGIN\"\"SPLADDR\"{fac}'-'\"END\" \"SPLADDR\"",
+		Semantic: "Contact data, where flight plan Supplementary information may be obtained.",
+		Children: []string{"FAC"},
+	},
+	"SPLC": {
+		Keyword:  "SPLC",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLC\" 1{ LIM_CHAR }50",
+		Semantic: "Name of pilot in command, as ICAO Field 19 element 'C/'.",
+	},
+	"SPLDCAP": {
+		Keyword:  "SPLDCAP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLDCAP\" 1{ DIGIT }3",
+		Semantic: "Dinghies total capacity, as ICAO Field 19 element 'D/'.",
+	},
+	"SPLDCOL": {
+		Keyword:  "SPLDCOL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLDCOL\" 1{ LIM_CHAR }50",
+		Semantic: "Dinghies: colour, as ICAO Field 19 element 'D/'.",
+	},
+	"SPLDCOV": {
+		Keyword:  "SPLDCOV",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLDCOV\" ('T' | 'F')",
+		Semantic: "Dinghies: indication if they are covered, as ICAO Field 19 element'D/'. T=True(=>'C'inICAO) F = False, not covered.",
+	},
+	"SPLDNB": {
+		Keyword:  "SPLDNB",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLDNB\" 1{ DIGIT }2",
+		Semantic: "Dinghies: number, as ICAO field 19 element 'D/'.",
+	},
+	"SPLE": {
+		Keyword:  "SPLE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLE\" timehhmm_elapsed",
+		Semantic: "Fuel endurance, as ICAO Field 19 element 'E/'.",
+	},
+	"SPLJ": {
+		Keyword:  "SPLJ",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLJ\" lifejackets",
+		Semantic: "Life jackets, as ICAO Feld 19 element 'J/'.",
+	},
+	"SPLN": {
+		Keyword:  "SPLN",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLN\" 1{ LIM_CHAR }",
+		Semantic: "Any other survival equipment and useful remarks, as ICAO Field 19 element 'N/'.",
+	},
+	"SPLP": {
+		Keyword:  "SPLP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLP\" 1{DIGIT}3",
+		Semantic: "Persons on board, as ICAO Field 19 element 'P/'.",
+	},
+	"SPLR": {
+		Keyword:  "SPLR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLR\" emergradio",
+		Semantic: "Emergency radio equipment, as ICAO Field 19 element 'R/'.",
+	},
+	"SPLS": {
+		Keyword:  "SPLS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SPLS\" survivaleqpt",
+		Semantic: "Survival equipment, as ICAO Field 19 element 'S/'.",
+	},
+	"SRC": {
+		Keyword:  "SRC",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SRC\" 1{ \"RPL\" | \"FPL\" | \"AFIL\" | \"MFS\" | \"FNM\" | \"RQP\" | \"AFP\" | \"DIV\" (icaoaerodrome | 'ZZZZ') }1",
+		Semantic: "Indication of the data source. Contents depend on the TITLE field.",
+	},
+	"SSRCODE": {
+		Keyword:  "SSRCODE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SSRCODE\" ('A' ! 4{ '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' }4 | \"REQ\" )",
+		Semantic: "Either; - SSR mode and code, as ICAO field 7 elements b and c. or - the letters \"REQ\" meaning that the code is requested.",
+	},
+	"STAR": {
+		Keyword:  "STAR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"STAR\" point ! 1{DIGIT}1 ! 0{ALPHA}1",
+		Semantic: "Identification of a Specification Arrival procedure.",
+	},
+	"STARTTIME": {
+		Keyword:  "STARTTIME",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"STARTTIME\" day ! timehhmm",
+		Semantic: "Time at which a period of time begins.",
+	},
+	"STATID": {
+		Keyword:  "STATID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"STATID\" coorstatusident",
+		Semantic: "Theindicatoroftheco-ordination state of a flight.",
+		Parents:  []string{"CSTAT"},
+	},
+	"STATREASON": {
+		Keyword:  "STATREASON",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"STATREASON\" coorstatusreason",
+		Semantic: "The reason for a change in the co- ordination status of a flight.",
+		Parents:  []string{"CSTAT"},
+	},
+	"STAY": {
+		Keyword:  "STAY",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"STAY\" stayident time ((adid adid) | (ptid ptid) (adid | ptid) | (ptid adid)) [ptspeed] [ptrfl]",
+		Semantic: "Indication in the route of flight of a period of 'special activity' when the aircraft will 'stay' in the area defined by the points and/or aerodromes given for the length of time indicated, i.e. training,mid-airre-fuelling,photographicmissionetc. NOTE: The order in which the points and/or aerodromes are given is significant",
+		Children: []string{"ADID", "PTID", "PTRFL", "PTSPEED", "STAYIDENT", "TIME"},
+	},
+	"STAYIDENT": {
+		Keyword:  "STAYIDENT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"STAYIDENT\" stayidentifier",
+		Semantic: "Identification of a period of 'special activity' or a 'stay' within the route of a flight.",
+		Parents:  []string{"STAY", "STAYINFO"},
+	},
+	"STAYINFO": {
+		Keyword:  "STAYINFO",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"STAYINFO\" stayident remark",
+		Semantic: "Informationconcerningthetypeofactivity(training, photographic mission, etc.) to be performed during a 'stay' period in the route of a flight.",
+		Children: []string{"REMARK", "STAYIDENT"},
+	},
+	"STO": {
+		Keyword:  "STO",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"STO\" timehhmm ! seconds",
+		Semantic: "Agenerictimefieldwhichmay contain the time for a point or for an aerodrome.Thetimemaybean estimated, calculated or actual time depending upon its context.",
+		Parents:  []string{"AD", "COORDATA", "PLANNEDPOSITION", "POSITION", "PT"},
+	},
+	"STS": {
+		Keyword:  "STS",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"STS\" flightplanstatus",
+		Semantic: "As ICAO Field 18 STS/. Reason for special handling.",
+	},
+	"SUR": {
+		Keyword:  "SUR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"SUR\" 1{LIM_CHAR}50",
+		Semantic: "AsICAOField18SUR/.Usedtoprovidesurveillance applications or capabilities not specified in -SEQPT\".",
+	},
+	"SUREQPT": {
+		Keyword:  "SUREQPT",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"SUREQPT\" surclass ! \"/\" ! eqptstatus [! \"/\" ! sureqptcode]",
+		Semantic: "Surveillanceequipmentclass, followedbyastatusvaluewhich specifiesthecurrentstatusofthe equipment.Whenappropriatethe current capability for the class may be provided.",
+		Parents:  []string{"EQCST"},
+	},
+	"TALT": {
+		Keyword:  "TALT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TALT\" (1 {LIM_CHAR} 100",
+		Semantic: "AsICAOField18TALT/.Anindicationofthetake-off alternate aerodrome",
+	},
+	"TAXITIME": {
+		Keyword:  "TAXITIME",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TAXITIME\" timehhmm",
+		Semantic: "The difference in time between the 'off blocks time' and the 'take-offtime'.Thetimesreferredtomaybeactualor estimated depending upon the context.",
+	},
+	"TFCVOL": {
+		Keyword:  "TFCVOL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TFCVOL\" 1 {ALPHANUM} 15",
+		Semantic: "Identification of a 'traffic volume'.",
+	},
+	"TFL": {
+		Keyword:  "TFL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"TFL\" flightlevel",
+		Semantic: "Transfer Flight Level. The flight level at which a flight has been or will be co-ordinatedtocrossonepoint (flight level number), if in level flight, ortheclearedleveltowhichitis proceedingifclimbingor descending at the boundary point.",
+		Parents:  []string{"COORDATA", "PROPFL"},
+	},
+	"TFV": {
+		Keyword:  "TFV",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"TFCVOL\" tfvid refloc flowlst flblock",
+		Semantic: "Description of a traffic volume.",
+		Children: []string{"FLBLOCK", "FLOWLST", "REFLOC", "TFVID"},
+	},
+	"TFVID": {
+		Keyword:  "TFVID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"TFVID\" 1{ALPHANUM}15",
+		Semantic: "Identification of a \"traffic volume\".",
+		Parents:  []string{"REGULATION", "RRTEFROM", "RRTETO", "TFV"},
+	},
+	"TIME": {
+		Keyword:  "TIME",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"TIME\" timehhmm",
+		Semantic: "A time indication. May be an actual time or a period of time, depending upon the message context.",
+		Parents:  []string{"EETLAT", "EETLONG", "STAY"},
+	},
+	"TIMESTAMP": {
+		Keyword:  "TIMESTAMP",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TIMESTAMP\" datetime ! seconds",
+		Semantic: "The time at which an event occurred.",
+	},
+	"TITLE": {
+		Keyword:  "TITLE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TITLE\" titleid",
+		Semantic: "Message title.",
+	},
+	"TO": {
+		Keyword:  "TO",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"TO\" timehhmm",
+		Semantic: "\"Time Over/Off\". A generic time field whichmaycontainthetimefora point or for an aerodrome. The time may be an estimated, calculated or actualtimedependinguponits context.",
+		Parents:  []string{"AD", "COORDATA", "PLANNEDPOSITION", "POSITION", "PT"},
+	},
+	"TOM": {
+		Keyword:  "TOM",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TOM\" timehhmm",
+		Semantic: "Thecalculatedtimeatwhichaflightshouldleavethe metering fix.",
+	},
+	"TOPOS": {
+		Keyword:  "TOPOS",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"TOPOS\" 1 {ALPHANUM} 15",
+		Semantic: "A position to which a route, a route portion, a 'path' or a flow extends. May be a region, an aerodrome or a significant point.",
+		Parents:  []string{"FLOW"},
+	},
+	"TRACK": {
+		Keyword:  "TRACK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TRACK\" heading|\"ZZZ\"",
+		Semantic: "Thetrackassignedtoaflightexpressedindegrees magnetic as three digits or the value 'ZZZ' indicating that no track is assigned.",
+	},
+	"TTG": {
+		Keyword:  "TTG",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TTG\" timemmss_elapsed",
+		Semantic: "Number of minutes and seconds that the flight has to gain before reaching the metering fix.",
+	},
+	"TTL": {
+		Keyword:  "TTL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TTL\" timemmss_elapsed",
+		Semantic: "Number of minutes and seconds that the flight has to lose before reaching the metering fix.",
+	},
+	"TTLEET": {
+		Keyword:  "TTLEET",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TTLEET\" timehhmm_elapsed",
+		Semantic: "Total estimated elapsed time in hours and minutes.",
+	},
+	"TTOT": {
+		Keyword:  "TTOT",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TTOT\" timehhmm",
+		Semantic: "Target take-off time.",
+	},
+	"TWYARR": {
+		Keyword:  "TWYARR",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TWYARR\" 1{LIM_CHAR}10",
+		Semantic: "Arrival Taxiway",
+	},
+	"VALIDEND": {
+		Keyword:  "VALIDEND",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALIDEND\" 1{LIM_CHAR}10",
+		Semantic: "Departure Taxiway",
+	},
+	"TXTIME": {
+		Keyword:  "TXTIME",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"TXTIME\" datetime seconds",
+		Semantic: "A transmission time indication.",
+		Parents:  []string{"SEQUENCEDATA"},
+	},
+	"TYPZ": {
+		Keyword:  "TYPZ",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"TYPZ\" 1 {LIM_CHAR} 60",
+		Semantic: "Type of aircraft when no ICAO code exists.",
+	},
+	"UNITID": {
+		Keyword:  "UNITID",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"UNITID\" 2{ ALPHANUM}10",
+		Semantic: "Identificationofanairnavigation uniti.e.anATCunit,aircraft operator or flight plan originator.",
+	},
+	"UNTIL": {
+		Keyword:  "UNTIL",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"UNTIL\" day!timehhmm",
+		Semantic: "The time at which a period of time ends.",
+		Parents:  []string{"RATEPERIOD", "RVRPERIOD"},
+	},
+	"VALFROM": {
+		Keyword:  "VALFROM",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALFROM\" date",
+		Semantic: "First date from which the flight is scheduled to operate (in year, month and day).",
+		Parents:  []string{"MSGSUM", "RFPDSUM"},
+	},
+	"VALFROMK": {
+		Keyword:  "VALFROMK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALFROMK\" ( date | datewldcrd )",
+		Semantic: "First date from which the flight is scheduled to operate, used asdatabasekeyinaquery,maybewildcarded. Must be a valid date or a combination of a valid date and wild-card characters.",
+	},
+	"VALFROMOLD": {
+		Keyword:  "VALFROMOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALFROMOLD\" date",
+		Semantic: "The\"previous\"\"valfrom\"date.Usedasadatabasekey. Where the start of validity date is to be amended, the new value will be given in \"VALFROM\".",
+	},
+	"VALIDITYDATE": {
+		Keyword:  "VALIDITYDATE",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALIDITYDATE\" date",
+		Semantic: "Date of validity.",
+	},
+	"VALPERIOD": {
+		Keyword:  "VALPERIOD",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-'\"VALPERIOD\"fulldatetime fulldatetime",
+		Semantic: "Avalidityperiod,inclusiveofthe times given.",
+		Parents:  []string{"AIRROUTE", "AIRSPACE"},
+	},
+	"VALUNTIL": {
+		Keyword:  "VALUNTIL",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALUNTIL\" date",
+		Semantic: "Last date from which the flight is scheduled to operate (in year, month and day).",
+		Parents:  []string{"MSGSUM", "RFPDSUM"},
+	},
+	"VALUNTILK": {
+		Keyword:  "VALUNTILK",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALUNTILK\" ( date | datewldcrd )",
+		Semantic: "Last date from which the flight is scheduled to operate, used asdatabasekeyinaQuery,maybewildcarded. Must be a valid date or a combination of a valid date and wild-card characters.",
+	},
+	"VALUNTILOLD": {
+		Keyword:  "VALUNTILOLD",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"VALUNTILOLD\" date",
+		Semantic: "The\"previous\"\"valuntil\"date.Usedasadatabasekey. Where the end of validity date is to be amended, the new value will be given in \"VALUNTIL\".",
+	},
+	"VEC": {
+		Keyword:  "VEC",
+		Class:    PrimaryField,
+		Kind:     Compound,
+		Syntax:   "'-' \"VEC\" fl eto reldist",
+		Semantic: "",
+		Children: []string{"ETO", "FL", "RELDIST"},
+		Parents:  []string{"RTEPTS"},
+	},
+	"VIA1": {
+		Keyword:  "VIA1",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"VIA1\" 1 {ALPHANUM} 15",
+		Semantic: "A point, an ATS route or an airspace which is either on or is required to be on the route of flight. When it is required to indicate more than one this field will contain the first in the sequence.",
+		Parents:  []string{"FLOW"},
+	},
+	"VIA2": {
+		Keyword:  "VIA2",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"VIA2\" 1 {ALPHANUM} 15",
+		Semantic: "A point, an ATS route or an airspace which is either on or is required to be on the route of flight. When it is required to indicate more than one this field will contain the second in the sequence.",
+		Parents:  []string{"FLOW"},
+	},
+	"VIA4": {
+		Keyword:  "VIA4",
+		Class:    Subfield,
+		Kind:     Basic,
+		Syntax:   "'-' \"VIA4\" 1 {ALPHANUM} 15",
+		Semantic: "A point, an ATS route or an airspace which is either on or is required to be on the route of flight. When it is required to indicate more than one this field will contain the fourth in the sequence.",
+		Parents:  []string{"FLOW"},
+	},
+	"WKTRC": {
+		Keyword:  "WKTRC",
+		Class:    PrimaryField,
+		Kind:     Basic,
+		Syntax:   "'-' \"WKTRC\" waketurbcat",
+		Semantic: "Wake turbulence category of the aircraft, as ICAO Field 9c.",
+	},
+}
+
+var terms = map[string]*AuxiliaryTerm{
+	"adexpmsg": {
+		Name:     "adexpmsg",
+		Syntax:   "{ CHARACTER }",
+		Semantic: "Freetextconformingto thesyntaxdescribedfor an ADEXP message.",
+	},
+	"aidequipment": {
+		Name:     "aidequipment",
+		Syntax:   "( ('N' | 'S') ! [ equipmentcode ] ) | equipmentcode",
+		Semantic: "Radiocommunication, navigationandapproach aid equipment.",
+		Fields:   []string{"CEQPT"},
+	},
+	"aircraftid": {
+		Name:     "aircraftid",
+		Syntax:   "2{ ALPHANUM }7",
+		Semantic: "Aircraft Identification.",
+	},
+	"aircraftidwldcrd": {
+		Name:     "aircraftidwldcrd",
+		Syntax:   "1{ ALPHANUM | '+' | '?' }7",
+		Semantic: "Wildcard form of aircraftid tobeusedinQuery messages: '?' replaces one character '+' replaces any number of characters.",
+		Fields:   []string{"ARCIDK"},
+	},
+	"atfmflightstate": {
+		Name:     "atfmflightstate",
+		Syntax:   "'FI' | 'FS' | 'SI' | 'TA' | 'AA' | 'CA' | 'TE' | 'SU'",
+		Semantic: "TheATFMstatusofa flight. FI=Filed. FS = Filed slot allocated. SI=Slotissued. TA=ETFMSactivated. AA=ATCactivated. CA=Cancelled. TE=Terminated. SU = Suspended.",
+		Fields:   []string{"FLTSTATE"},
+	},
+	"atfmmodeltype": {
+		Name:     "atfmmodeltype",
+		Syntax:   "'EST' | ' CAL' | ' ACT'",
+		Semantic: "Thetypeofflightmodel included. EST=Theestimated model. CAL=Thecalculated model. ACT = The actual model.",
+		Fields:   []string{"MODELTYP"},
+	},
+	"atfmrdystate": {
+		Name:     "atfmrdystate",
+		Syntax:   "'D' | 'N'",
+		Semantic: "Thereadystatusofthe flight. D=Readytodepart. N = Not ready to depart.",
+		Fields:   []string{"RDYSTATE"},
+	},
+	"atfmreasonclass": {
+		Name:     "atfmreasonclass",
+		Syntax:   "'MSG' | 'SYS' | ' REG' | ' MAN'",
+		Semantic: "TheATFMreasonfor which a message is sent. MSG = The source of the messageisanincoming oroutgoingmessage. SYS=Themessageis automaticallygenerated byatimetriggerevent. REG=Themessageis automaticallygenerated byaslotrecalculation event. MAN=Themessageis triggered by an FMD user command.",
+		Fields:   []string{"EVENTCLASS"},
+	},
+	"atsroute": {
+		Name:     "atsroute",
+		Syntax:   "2{ALPHANUM}7",
+		Semantic: "Identifier of an ATS route.",
+	},
+	"century": {
+		Name:     "century",
+		Syntax:   "2{DIGIT}2",
+		Semantic: "Twofirstdigitsofa century.",
+		Terms:    []string{"fulldate"},
+	},
+	"coorstatusident": {
+		Name:     "coorstatusident",
+		Syntax:   "3 {ALPHA} 3",
+		Semantic: "Anindicatoroftheco- ordinationstatusofa flight.",
+		Fields:   []string{"STATID"},
+	},
+	"coorstatusreason": {
+		Name:     "coorstatusreason",
+		Syntax:   "3 {ALPHA} 7",
+		Semantic: "The reason for notifying a changeintheco- ordination status.",
+		Fields:   []string{"STATREASON"},
+	},
+	"country": {
+		Name:     "country",
+		Syntax:   "2{ALPHA}2",
+		Semantic: "ThetwoletterICAO designator of a country.",
+		Fields:   []string{"REFATSRTE"},
+	},
+	"datalink": {
+		Name:     "datalink",
+		Syntax:   "1{LIM_CHAR}50",
+		Semantic: "One to 50 characters describing datalink applications or capabilities not specified elsewhere.",
+		Fields:   []string{"DAT"},
+	},
+	"date": {
+		Name:     "date",
+		Syntax:   "year ! month ! day",
+		Semantic: "Adateindicationinthe format,YYMMDD. e.g. 930424 = 24th. April 1993.",
+		Fields:   []string{"ETO"},
+		Terms:    []string{"datetime"},
+	},
+	"datetime": {
+		Name:     "datetime",
+		Syntax:   "date ! timehhmm",
+		Semantic: "A\"date\"termas describedaboveand immediatelyfollowedby thetimeintheformat, HHMM. e.g. 9304240930 = 0930Z on the 24th. April 1993.",
+	},
+	"datewldcrd": {
+		Name:     "datewldcrd",
+		Syntax:   "1{ DIGIT | '+' | '?' )6",
+		Semantic: "A \"date\" term which may be wild carded.",
+	},
+	"day": {
+		Name:     "day",
+		Syntax:   "('0' | '1' | '2' | '3') ! DIGIT",
+		Semantic: "A two digit number which maycontainthedigits from 00 to 31.",
+	},
+	"emergradio": {
+		Name:     "emergradio",
+		Syntax:   "1 {'U' | 'V' | 'E' } 3",
+		Semantic: "Indicatorofthetypeof emergencyradio equipmentonboardthe aircraft.Maybeoneor moreofthedefined charactersinanyorder but without repetition.",
+		Fields:   []string{"SPLR"},
+	},
+	"eqptcode": {
+		Name:     "eqptcode",
+		Syntax:   "1{\"A\" | \"B\" | \"C\" | \"D\" | \"E1\" | \"E2\" | \"E3\" | \"F\" | \"G\" | \"H\" | \"I\" | \"J1\" | \"J2\"| \"J3\" | \"J4\" | \"J5\" | \"J6\" | \"J7\" | \"K\" | \"L\" | \"M1\" | \"M2\"| \"M3\" | \"O\" | \"P1\"| \"P2\"| \"P3\"|\"P4\"|\"P5\"|\"P6\"|\"P7\"| \"P8\"| \"P9\" | \"R\" | \"S\" | \"T\" | \"U\" | \"V\" | \"W\" | \"X\" | \"Y\" | \"Z\"}1",
+		Semantic: "Code which identifies an equipmentcapability. Maybeidenticalto equipmentcode.",
+		Fields:   []string{"EQPT"},
+	},
+	"eqptstatus": {
+		Name:     "eqptstatus",
+		Syntax:   "1{ \"EQ\" | \"UN\" | \"NO\" }1",
+		Semantic: "A status value describing the status of the aircraft equipment / capability where: \"EQ\" means the flight complies with the specified capability and/or the flight is equipped and the equipment is available for use \"UN\" means compliance with the capability is unknown and/or equipage status is unknown \"NO\" means the flight does not comply with the specified capability and/or the flight is not equipped or the equipment is unavailable for use",
+	},
+	"equipmentcode": {
+		Name:     "equipmentcode",
+		Syntax:   "1{ ALPHANUM }",
+		Semantic: "The ICAO designators of the radiocommunication, navigation and approach aid equipment and capabilities.",
+	},
+	"errorcode": {
+		Name:     "errorcode",
+		Syntax:   "1{DIGIT}4",
+		Semantic: "Errormessagecode number.",
+		Fields:   []string{"ERROR"},
+	},
+	"eurflightplanstatus": {
+		Name:     "eurflightplanstatus",
+		Syntax:   "1{ \"PROTECTED\" }",
+		Semantic: "Anindicationofan exemptionorspecial staus applicable to a flight within the EUR region.",
+		Fields:   []string{"EUR"},
+	},
+	"eventtype": {
+		Name:     "eventtype",
+		Syntax:   "3{ALPHANUM}3",
+		Semantic: "Indicatingthetypeof event",
+		Fields:   []string{"EVENT"},
+	},
+	"fieldid": {
+		Name:     "fieldid",
+		Syntax:   "1{ ALPHANUM }",
+		Semantic: "ValidADEXPfieldname (i.e. keyword).",
+	},
+	"firindicator": {
+		Name:     "firindicator",
+		Syntax:   "4{ ALPHA }4",
+		Semantic: "An ICAO designator of an FIR.",
+		Fields:   []string{"EETFIR"},
+	},
+	"flightlevel": {
+		Name:     "flightlevel",
+		Syntax:   "('F' ! 3{DIGIT}3) | ('A' ! 3{DIGIT}3) | ('S' ! 4{DIGIT}4) | ('M' ! 4{DIGIT}4)",
+		Semantic: "A flight level or altitude, in hundreds of feet (F), tens of metres (S), or as an altitude in hundreds of feet (A) or tens of metres (M).",
+	},
+	"flightplanstatus": {
+		Name:     "flightplanstatus",
+		Syntax:   "[ \"ALTRV\" | \"ATFMX\" | \"FFR\" | \"FLTCK\" | \" HAZMAT\" | \"HEAD\" | \"HOSP\" | \"HUM\" | \"MARSA\" | \"MEDEVAC\" | \"NONRVSM\" | \"SAR\" | \"STATE\" ]",
+		Semantic: "Thereasonforspecial treatmentasindicatedin Field18element'STS/'. ALTRV: for a flight operated inaccordancewithan altitude reservation; ATFMX:foraflight approvedforexemption from ATFM measures; FFR: fire fighting; FLTCK:flightcheckfor calibration of navaids; HAZMAT:foraflight carrying hazardous material; HEAD for a flight with Head of State status; HOSP:foramedicalflight declaredbymedical authorities; HUM: for a flight operating on a humanitarian mission; MARSA:foraflightfor whichamilitaryentity assumesresponsibilityfor separationofmilitary aircraft; MEDEVAC: for a life critical medicalemergency evacuation; NONRVSM:foranon- RVSMcapableflight intendingtooperatein RVSM airspace; SAR: for a flight engaged in asearchandrescue mission; STATE: for a flight engaged in military, customs or police services.",
+		Fields:   []string{"STS"},
+	},
+	"flightrule": {
+		Name:     "flightrule",
+		Syntax:   "'I' | 'V' | 'Y' | 'Z'",
+		Semantic: "The flight rule indicator of a flight.",
+		Fields:   []string{"FLTRUL"},
+	},
+	"flighttype": {
+		Name:     "flighttype",
+		Syntax:   "'S' | 'N' | 'G' | 'M' | 'X'",
+		Semantic: "Thetypeofflightas indicatedbytheICAO designator used.",
+		Fields:   []string{"FLTTYP"},
+	},
+	"flighttypechg": {
+		Name:     "flighttypechg",
+		Syntax:   "'OAT' | 'GAT'",
+		Semantic: "The indication provided in therouteofflightofa change in the type of flight to 'OAT' or 'GAT' .",
+		Fields:   []string{"PTRULCHG"},
+	},
+	"fulldate": {
+		Name:     "fulldate",
+		Syntax:   "century ! year ! month ! day",
+		Semantic: "Adateindicationinthe format CCYYMMDD eg. 19970801 = 1st. Aug. 1997",
+		Terms:    []string{"fulldatetime"},
+	},
+	"fulldatetime": {
+		Name:     "fulldatetime",
+		Syntax:   "fulldate ! timehhmm",
+		Semantic: "Adate,asdescribedin 'fulldate', and immediately followed by the time in the format HHMM e.g.199708010930= 0930 hours on 1st. Aug. 1997",
+		Fields:   []string{"MESVALPERIOD"},
+	},
+	"geoname": {
+		Name:     "geoname",
+		Syntax:   "\"GEO\" ! 2{DIGIT}2",
+		Semantic: "The identification given to ageographicalposition expressed in latitude and longitude.",
+		Fields:   []string{"GEOID"},
+	},
+	"heading": {
+		Name:     "heading",
+		Syntax:   "3{DIGIT}3",
+		Semantic: "Athreedigitnumberin the range 001 to 360.",
+	},
+	"hexadecimal": {
+		Name:     "hexadecimal",
+		Syntax:   "DIGIT | 'A' | 'B' | 'C' | 'D' | 'E' | 'F'",
+		Semantic: "A hexadecimal digit.",
+	},
+	"iatadelaycode": {
+		Name:     "iatadelaycode",
+		Syntax:   "2{DIGIT}2",
+		Semantic: "IATA delay code",
+		Fields:   []string{"REGCAUSE"},
+	},
+	"iatalocationcat": {
+		Name:     "iatalocationcat",
+		Syntax:   "['A' | 'D' | 'E']",
+		Semantic: "Regulation location code. A = Arrival D = Departure E = En-route",
+		Fields:   []string{"REGCAUSE"},
+	},
+	"icaoaerodrome": {
+		Name:     "icaoaerodrome",
+		Syntax:   "4{ ALPHA }4",
+		Semantic: "AfourletterICAO designatorforan aerodrome.",
+		Fields:   []string{"ADID"},
+	},
+	"icaoaerodromewldcrd": {
+		Name:     "icaoaerodromewldcrd",
+		Syntax:   "1{ ALPHA | '+' | '?' }4",
+		Semantic: "Wildcardformof icaoaerodrome,tobe used in Query messages: '?' replaces one character '+' replaces any number of characters.",
+	},
+	"icaoaircrafttype": {
+		Name:     "icaoaircrafttype",
+		Syntax:   "ALPHA ! 1{ ALPHANUM }3",
+		Semantic: "An ICAO designator of an aircraft type.",
+		Fields:   []string{"ARCTYP"},
+	},
+	"icaomsg": {
+		Name:     "icaomsg",
+		Syntax:   "{ CHARACTER }",
+		Semantic: "AnICAOmessage. (conforming to the syntax described in Ref. {5})",
+		Fields:   []string{"MSGTXT"},
+	},
+	"ifpsprocess": {
+		Name:     "ifpsprocess",
+		Syntax:   "[\"IFPSTART\" | \"IFPSTOP\"]",
+		Semantic: "IndicationofinitialIFPS processing of the flight.",
+		Fields:   []string{"IRULES", "PTRULCHG"},
+	},
+	"ifpuid": {
+		Name:     "ifpuid",
+		Syntax:   "1{ ALPHANUM }",
+		Semantic: "The identifier of an IFPS Unit.",
+		Fields:   []string{"IFPURESP"},
+	},
+	"ifpvalue": {
+		Name:     "ifpvalue",
+		Syntax:   "1{\"ERROUTRAD\" | \"ERROUTWE\" | \"ERROUTE\" | \"ERRTYPE\" | \"ERRLEVEL\" | \"ERREOBT\" | \"NON833\" | \"833UNKNOWN\" | \"MODESASP\" | \"RVSMVIOLATION\" | \"NONRVSM\" | \"RVSMUNKNOWN\"}",
+		Semantic: "Oneormoreindicators used to provide ATC with additionalinformation concerning a flight.",
+		Fields:   []string{"IFP"},
+	},
+	"latitudelong": {
+		Name:     "latitudelong",
+		Syntax:   "6{ DIGIT }6",
+		Semantic: "Alatitudeexpressedas six digits.",
+		Fields:   []string{"LATTD"},
+	},
+	"latitudeside": {
+		Name:     "latitudeside",
+		Syntax:   "'N' | 'S'",
+		Semantic: "An indicator for \"North\" or \"South\" latitude.",
+		Fields:   []string{"LATTD"},
+	},
+	"lifejackets": {
+		Name:     "lifejackets",
+		Syntax:   "1 {'L' | 'F' | 'U' | 'V'} 4",
+		Semantic: "The ICAO indicator of the typeoflifejacketscarried. May be one or more of the definedcharactersinany order but without repetition.",
+		Fields:   []string{"SPLJ"},
+	},
+	"longitudelong": {
+		Name:     "longitudelong",
+		Syntax:   "7{ DIGIT }7",
+		Semantic: "A longitude expressed as seven digits.",
+		Fields:   []string{"LONGTD"},
+	},
+	"longitudeside": {
+		Name:     "longitudeside",
+		Syntax:   "'E' | 'W'",
+		Semantic: "An indicator for \"East\" or \"West\" longitude.",
+		Fields:   []string{"LONGTD"},
+	},
+	"machnumber": {
+		Name:     "machnumber",
+		Syntax:   "'M' ! 3{ DIGIT }3",
+		Semantic: "The Mach number.",
+	},
+	"modifind": {
+		Name:     "modifind",
+		Syntax:   "1{ALPHANUM}",
+		Semantic: "Indicationofthetypeof modificationmadetoa field.",
+		Fields:   []string{"IFPSMOD"},
+	},
+	"month": {
+		Name:     "month",
+		Syntax:   "('0' | '1' ) ! DIGIT",
+		Semantic: "Month,expressedasa two digit number.",
+	},
+	"numdays": {
+		Name:     "numdays",
+		Syntax:   "('0' | '1') ! ('0' | '2') ! ('0' | '3') ! ('0' | '4') ! ('0' | '5') ! ('0' | '6') ! ('0' | '7')",
+		Semantic: "The indication of the days oftheweekonwhicha RPL is active.",
+	},
+	"numdayswldcrd": {
+		Name:     "numdayswldcrd",
+		Syntax:   "1{ DIGIT | '+' | '?' }7",
+		Semantic: "The indication of the days oftheweekonwhicha RPLisactive.Wildcard charactersmayalsobe used.",
+		Fields:   []string{"DAYSK"},
+	},
+	"pbncode": {
+		Name:     "pbncode",
+		Syntax:   "1{ \"A1\" | \"B1\" | \"B2\" | \"B3\" | \"B4\" | \"B5\" | \"B6\" | \"C1\" | \"C2\" | \"C3\" | \"C4\" | \"D1\" | \"D2\" | \"D3\" | \"D4\" | \"L1\" | \"O1\" | \"O2\" | \"O3\" | \"O4\" | \"S1\" | \"S2\" | \"T1\" | \"T2\"}8",
+		Semantic: "ICAO defined codes giving the performance based navigation capability.",
+		Fields:   []string{"PBN"},
+	},
+	"performancecategory": {
+		Name:     "performancecategory",
+		Syntax:   "1 { [ \"A\" | \"B\" | \"C\" | \"D\" | \"E\" | \"H\"] } 1",
+		Semantic: "ICAO defined codes giving the performance category of the aircraft",
+		Fields:   []string{"PER"},
+	},
+	"point": {
+		Name:     "point",
+		Syntax:   "2{ ALPHANUM }5",
+		Semantic: "Thedesignatorofa significant point. May be a publishedpoint,a geographicalpoint,a reference point or a point given artificially such as a 're-named' point (RENxx).",
+	},
+	"readyforimpr": {
+		Name:     "readyforimpr",
+		Syntax:   "'I' | 'S'",
+		Semantic: "Thereadystatusofthe flight. I=Readyfor improvement. S = SIP wanted.",
+		Fields:   []string{"RDYSTATE"},
+	},
+	"refbearing": {
+		Name:     "refbearing",
+		Syntax:   "3{ DIGIT }3",
+		Semantic: "Reference Bearing value.",
+		Fields:   []string{"BRNG"},
+	},
+	"refname": {
+		Name:     "refname",
+		Syntax:   "\"REF\" ! 2{DIGIT}2",
+		Semantic: "Theidentifiergiventoa pointexpressedby bearing and distance from a published point",
+		Fields:   []string{"REFID"},
+	},
+	"regulationreason": {
+		Name:     "regulationreason",
+		Syntax:   "['A' | 'C' | 'D' | 'E' | 'G' | 'I'| 'M' | 'N' | 'O'| 'P' | 'R' | 'S'| 'T' | 'V'| 'W'",
+		Semantic: "The CFMU designator of thereasonfora regulation.",
+		Fields:   []string{"REGCAUSE"},
+	},
+	"regulid": {
+		Name:     "regulid",
+		Syntax:   "1{ ALPHANUM }20",
+		Semantic: "Theidentificationofan ATFMregulation concerning a flight.",
+		Fields:   []string{"REGUL", "REGID"},
+	},
+	"renameid": {
+		Name:     "renameid",
+		Syntax:   "\"REN\" ! 2{DIGIT}2",
+		Semantic: "Identifierofare-named point.",
+		Fields:   []string{"RENID"},
+	},
+	"rrteid": {
+		Name:     "rrteid",
+		Syntax:   "1{ ALPHANUM} 20",
+		Semantic: "Theidentifierofare- routing.",
+		Fields:   []string{"RRTEREF"},
+	},
+	"rtf": {
+		Name:     "rtf",
+		Syntax:   "6{DIGIT}6",
+		Semantic: "Aradiofrequency expressed in MHz to three decimal places.",
+		Fields:   []string{"FREQ"},
+	},
+	"rulechg": {
+		Name:     "rulechg",
+		Syntax:   "'VFR' | 'IFR'",
+		Semantic: "The indicators used in the route of a flight to indicate achangeintheflight rules.",
+		Fields:   []string{"PTRULCHG"},
+	},
+	"seconds": {
+		Name:     "seconds",
+		Syntax:   "( '0' | '1' | '2' | '3' | '4' | '5' ) ! DIGIT",
+		Semantic: "Seconds. Twodigitsfrom\"00\"to \"59\".",
+	},
+	"spd": {
+		Name:     "spd",
+		Syntax:   "('N' ! 4{DIGIT}4) | ('M' ! 3{DIGIT}3) | ('K' ! 4{DIGIT}4)",
+		Semantic: "A speed, as a true air speed in knots (N) or kilometres per hour (K), or as a Mach number in hundredths (M).",
+	},
+	"stayidentifier": {
+		Name:     "stayidentifier",
+		Syntax:   "'STAY' ! ( '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' )",
+		Semantic: "Designatorofa'stay' period, a period of 'special activity' within the route of a flight.",
+	},
+	"surclass": {
+		Name:     "surclass",
+		Syntax:   "1{\"A\"|\"S\"|\"ADSB\"|\"ADSC\"}1",
+		Semantic: "Surveillance equipment class where A=Modes A&C; S=ModeS; ADSB=ADS-B; ADSC=ADS-C",
+		Fields:   []string{"SUREQPT"},
+	},
+	"sureqptcode": {
+		Name:     "sureqptcode",
+		Syntax:   "[\"A\" | \"B1\"| \"B2\" | \"C\"| \"D1\" | \"E\" | \"G1\" | \"H\" | \"I\" | \"L\" | \"P\" | \"S\" | \"U1\" | \"U2\" | \"V1\" | \"V2\" | \"X\"]",
+		Semantic: "Codes as specified by ICAO to indicate surveillance equipment carried.",
+		Fields:   []string{"SUREQPT"},
+	},
+	"surequipment": {
+		Name:     "surequipment",
+		Syntax:   "\"N\" | (1{ (\"I\" | \"P\" | \"X\") | \"A\" | \"C\" }3) | (1{ \"A\" | \"C\" | \"E\" | \"H\" | \"L\" | \"S\"}6) [1{ \"B1\"| \"B2\" |\"D1\" | \"G1\" | \"U1\" | \"U2\" | \"V1\" | \"V2\" }8 ] Note: A total limit of 20 characters is applied",
+		Semantic: "The ICAO designator of the surveillance capabilities and equipment carried.. The descriptor 'N' or, either one or more of the descriptors 'I', 'P', 'X', 'A', 'C' with 'I', 'P', 'X' being mutually exclusive i.e. only one may be present, or one or more of the descriptors 'A', 'C', 'E', 'H', 'L', 'S'. Plus optionally one or more of the descriptors 'B1', 'B2', 'D1', 'G1', 'U1', 'U2', 'V1', 'V2' without repetition. A total limit of 20 characters is applied.",
+		Fields:   []string{"SEQPT"},
+	},
+	"survivaleqpt": {
+		Name:     "survivaleqpt",
+		Syntax:   "1 {'P' | 'D' | 'M' | 'J' } 4",
+		Semantic: "The ICAO designator of the survival equipment carried. May be one or more of the definedcharactersinany order but without repetition.",
+		Fields:   []string{"SPLS"},
+	},
+	"timehhmm": {
+		Name:     "timehhmm",
+		Syntax:   "( '0' | '1' | '2' ) ! DIGIT ! ( '0' | '1' | '2' | '3' | '4' | '5' ) ! DIGIT",
+		Semantic: "Time, expressed in hours (2digits00-23)and minutes(2digits00-59). May be the time of day or a duration.",
+	},
+	"timehhmm_elapsed": {
+		Name:     "timehhmm_elapsed",
+		Syntax:   "DIGIT ! DIGIT ! ('0' | '1' | '2' | '3' | '4' | '5' ) ! DIGIT",
+		Semantic: "Anunlimitednumberof hours and minutes, used for durations.",
+	},
+	"timemmss_elapsed": {
+		Name:     "timemmss_elapsed",
+		Syntax:   "4{DIGIT}4",
+		Semantic: "An elapsed time, in minutes and seconds (MMSS).",
+	},
+	"timewldcrd": {
+		Name:     "timewldcrd",
+		Syntax:   "1{ DIGIT | '+' | '?' }4",
+		Semantic: "Wildcardformofa timehhmm.",
+		Fields:   []string{"EOBTK"},
+	},
+	"titleid": {
+		Name:     "titleid",
+		Syntax:   "1{ ALPHA }10",
+		Semantic: "A valid ADEXP message title. (see Annex B)",
+	},
+	"waketurbcat": {
+		Name:     "waketurbcat",
+		Syntax:   "'H' | 'M' | 'L' | 'J'",
+		Semantic: "TheICAOwake turbulencecategory designator.",
+		Fields:   []string{"WKTRC"},
+	},
+	"year": {
+		Name:     "year",
+		Syntax:   "2{ DIGIT }2",
+		Semantic: "Two last digits of a year.",
+	},
+}
+
+var titles = map[string]*Title{
+	"ABI":     {Title: "ABI", Definition: "Advance Boundary Information Message"},
+	"ACK":     {Title: "ACK", Definition: "Acknowledge Message"},
+	"ACP":     {Title: "ACP", Definition: "Acceptance Message"},
+	"ACT":     {Title: "ACT", Definition: "Activation Message"},
+	"ACH":     {Title: "ACH", Definition: "ATC Flight Plan Change Message"},
+	"AFP":     {Title: "AFP", Definition: "ATC Flight Plan Proposal Message"},
+	"AMA":     {Title: "AMA", Definition: "Arrival Management Message"},
+	"APL":     {Title: "APL", Definition: "ATC Flight Plan Message"},
+	"APR":     {Title: "APR", Definition: "Aircraft Position Report Message"},
+	"AUP":     {Title: "AUP", Definition: "Airspace Use Plan Message"},
+	"BFD":     {Title: "BFD", Definition: "Basic Flight Data Message"},
+	"CAM":     {Title: "CAM", Definition: "Code Assignment Message"},
+	"CAL":     {Title: "CAL", Definition: "CCAMS Alive Message"},
+	"CAR":     {Title: "CAR", Definition: "CCAMS Alive Request Message"},
+	"CCM":     {Title: "CCM", Definition: "Code Cancellation Message"},
+	"CDN":     {Title: "CDN", Definition: "Co-ordination Message"},
+	"CFD":     {Title: "CFD", Definition: "Change to Flight Data Message"},
+	"COR":     {Title: "COR", Definition: "Code Request Message"},
+	"CRE":     {Title: "CRE", Definition: "Code Release Message"},
+	"CRQ":     {Title: "CRQ", Definition: "Clearance Request Message"},
+	"CRP":     {Title: "CRP", Definition: "Clearance Response Message"},
+	"CNLCOND": {Title: "CNLCOND", Definition: "ATFM Exceptional Condition Cancellation Message"},
+	"CNLREG":  {Title: "CNLREG", Definition: "ATFM Regulation Cancellation Message"},
+	"COD":     {Title: "COD", Definition: "SSR Code Assignment Message"},
+	"COF":     {Title: "COF", Definition: "Change of Frequency Message"},
+	"CRAM":    {Title: "CRAM", Definition: "Conditional Route Availability Message"},
+	"DES":     {Title: "DES", Definition: "De-Suspension Message"},
+	"DPI":     {Title: "DPI", Definition: "Departure Planning Information Message"},
+	"EFD":     {Title: "EFD", Definition: "ETFMS Flight Data Message"},
+	"ERR":     {Title: "ERR", Definition: "Error Message"},
+	"EXCOND":  {Title: "EXCOND", Definition: "ATFM Exceptional Condition Notification Message"},
+	"FCM":     {Title: "FCM", Definition: "Flight Confirmation Message"},
+	"FLS":     {Title: "FLS", Definition: "Flight Suspension Message"},
+	"FSA":     {Title: "FSA", Definition: "First System Activation Message"},
+	"FUM":     {Title: "FUM", Definition: "Flight Update Message"},
+	"HOP":     {Title: "HOP", Definition: "Hand-Over Proposal Message"},
+	"IACH":    {Title: "IACH", Definition: "Individual ATC Modification Message"},
+	"IAFP":    {Title: "IAFP", Definition: "Individual ATC Flight Plan Proposal Message"},
+	"IAPL":    {Title: "IAPL", Definition: "Individual ATC Flight Plan Message"},
+	"IARR":    {Title: "IARR", Definition: "Individual Arrival Message"},
+	"ICHG":    {Title: "ICHG", Definition: "Individual Modification Message"},
+	"ICNL":    {Title: "ICNL", Definition: "Individual Cancellation Message"},
+	"IDEP":    {Title: "IDEP", Definition: "Individual Departure Message"},
+	"IDLA":    {Title: "IDLA", Definition: "Individual Delay Message"},
+	"IFPL":    {Title: "IFPL", Definition: "Individual Flight Plan Message"},
+	"INF":     {Title: "INF", Definition: "Information Message"},
+	"IRPL":    {Title: "IRPL", Definition: "Individual Repetitive Flight Plan"},
+	"IRQP":    {Title: "IRQP", Definition: "Individual Request Flight Plan Message"},
+	"IRQS":    {Title: "IRQS", Definition: "Individual Request Supplementary Flight Plan"},
+	"ISPL":    {Title: "ISPL", Definition: "Individual Supplementary Flight Plan"},
+	"LAM":     {Title: "LAM", Definition: "Logical Acknowledgement Message"},
+	"LOF":     {Title: "LOF", Definition: "Logon Forward Message"},
+	"LRM":     {Title: "LRM", Definition: "Logical Rejection Message"},
+	"MAC":     {Title: "MAC", Definition: "Message for Abrogation of Co-ordination"},
+	"MAN":     {Title: "MAN", Definition: "Manual Processing Pending Message"},
+	"MAS":     {Title: "MAS", Definition: "Manual Assumption of Communications Message"},
+	"MODCOND": {Title: "MODCOND", Definition: "ATFM Exceptional Condition Modification Message"},
+	"MODREG":  {Title: "MODREG", Definition: "ATFM Regulation Modification Message"},
+	"MRA":     {Title: "MRA", Definition: "Mandatory Route Activation Message"},
+	"MRCNL":   {Title: "MRCNL", Definition: "Mandatory Route Cancellation Message"},
+	"MRMOD":   {Title: "MRMOD", Definition: "Mandatory Route Modification Message"},
+	"NAN":     {Title: "NAN", Definition: "Next Authority Notified Message"},
+	"NEWREG":  {Title: "NEWREG", Definition: "New ATFM Regulation Notification Message"},
+	"NTA":     {Title: "NTA", Definition: "No Traffic Accepted Message"},
+	"NTACNL":  {Title: "NTACNL", Definition: "No Traffic Accepted Cancellation Message"},
+	"NTAMOD":  {Title: "NTAMOD", Definition: "No Traffic Accepted Modification Message"},
+	"OCM":     {Title: "OCM", Definition: "Oceanic Clearance Message"},
+	"OLRA":    {Title: "OLRA", Definition: "Off-Load Route Activation Message"},
+	"OLRCNL":  {Title: "OLRCNL", Definition: "Off-Load Route Cancellation Message"},
+	"OLRMOD":  {Title: "OLRMOD", Definition: "Off-Load Route Modification Message"},
+	"PAC":     {Title: "PAC", Definition: "Preliminary Activation Message"},
+	"PNT":     {Title: "PNT", Definition: "Point Message"},
+	"RAP":     {Title: "RAP", Definition: "Referred Activate Proposal Message"},
+	"RCHG":    {Title: "RCHG", Definition: "Repetitive Flight Plan Data Modification Message"},
+	"RCL":     {Title: "RCL", Definition: "Request Oceanic Clearance Message"},
+	"RCNL":    {Title: "RCNL", Definition: "Repetitive Flight Plan Data Cancellation Message"},
+	"RDY":     {Title: "RDY", Definition: "Ready Message"},
+	"REJ":     {Title: "REJ", Definition: "Rejection Message"},
+	"REV":     {Title: "REV", Definition: "Revision Message"},
+	"RJC":     {Title: "RJC", Definition: "Reject Co-ordination Message"},
+	"RJT":     {Title: "RJT", Definition: "Re-Routing Rejection Message"},
+	"RLS":     {Title: "RLS", Definition: "Release Message"},
+	"ROF":     {Title: "ROF", Definition: "Request On Frequency Message"},
+	"RRQ":     {Title: "RRQ", Definition: "Release Request Message"},
+	"RRP":     {Title: "RRP", Definition: "Re-Routing Proposal Message"},
+	"RRV":     {Title: "RRV", Definition: "Referred Revision Proposal Message"},
+	"RTI":     {Title: "RTI", Definition: "Request Tactical Instructions Message"},
+	"SAM":     {Title: "SAM", Definition: "Slot Allocation Message"},
+	"SBY":     {Title: "SBY", Definition: "Stand-by Message"},
+	"SCO":     {Title: "SCO", Definition: "Skip Communication"},
+	"SDM":     {Title: "SDM", Definition: "Supplementary Data Message"},
+	"SIP":     {Title: "SIP", Definition: "Slot Improvement Proposal Message"},
+	"SKC":     {Title: "SKC", Definition: "Skip Cancellation Message"},
+	"SLC":     {Title: "SLC", Definition: "Slot Requirement Cancellation Message"},
+	"SMM":     {Title: "SMM", Definition: "Slot Missed Message"},
+	"SPA":     {Title: "SPA", Definition: "Slot Proposal Acceptance Message"},
+	"SRJ":     {Title: "SRJ", Definition: "Slot Proposal Rejection Message"},
+	"SRM":     {Title: "SRM", Definition: "Slot Revision Message"},
+	"SRR":     {Title: "SRR", Definition: "Slot Revision Request Message"},
+	"TIM":     {Title: "TIM", Definition: "Transfer Initiation Message"},
+	"TIP":     {Title: "TIP", Definition: "Tactical Instructions Proposal Message"},
+	"UUP":     {Title: "UUP", Definition: "Updated Airspace Use Plan Message"},
+	"WRN":     {Title: "WRN", Definition: "Warning Message"},
+	"XAP":     {Title: "XAP", Definition: "Crossing Alternate Proposal Message"},
+	"XCM":     {Title: "XCM", Definition: "Crossing Cancellation Message"},
+	"XIN":     {Title: "XIN", Definition: "Crossing Intention Notification Message"},
+	"XRQ":     {Title: "XRQ", Definition: "Crossing Request Message"},
+}
+
+var indicators = map[string]string{
+	"ALTN": "ALTNZ",
+	"CODE": "ARCADDR",
+	"COM":  "COM",
+	"DAT":  "DAT",
+	"DEP":  "DEPZ",
+	"DEST": "DESTZ",
+	"DOF":  "EOBD",
+	"EET":  "EETPT",
+	"EUR":  "EUR",
+	"IFP":  "IFP",
+	"NAV":  "NAV",
+	"OPR":  "OPR",
+	"ORGN": "ORGN",
+	"PBN":  "PBN",
+	"PER":  "PER",
+	"RALT": "RALT",
+	"REG":  "REG",
+	"RFP":  "RFP",
+	"RIF":  "RIF",
+	"RMK":  "RMK",
+	"SEL":  "SEL",
+	"STS":  "STS",
+	"SUR":  "SUR",
+	"TALT": "TALT",
+	"TYP":  "TYPZ",
+}
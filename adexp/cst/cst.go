@@ -0,0 +1,153 @@
+/*
+Package cst provides the concrete syntax tree of ADEXP documents, so that they can be edited and written back losslessly.
+
+Each token of a document keeps its raw text along with the separators preceding it, so that an unmodified tree is
+written back byte for byte identical to its input: the whitespace, the line breaks and the order of the fields are kept.
+Only the edited tokens change.
+*/
+package cst
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/aabizri/aero/adexp/parser"
+
+	"github.com/pkg/errors"
+)
+
+// A Token is a lexeme of the document, with the separators preceding it
+type Token struct {
+	Leading string // Leading are the separators preceding the token
+	Text    string // Text is the raw text of the token, such as "-ARCID", "-BEGIN", "ADDR" or "AFR 456"
+}
+
+// A Document is the concrete syntax tree of an ADEXP document
+type Document struct {
+	Fields   []*Field
+	Trailing string // Trailing are the separators after the last field
+}
+
+// A Field is a field of a document, along with its subfields or elements
+type Field struct {
+	Kind    parser.Kind
+	Keyword Token // Keyword is the hyphen & keyword, such as "-ARCID", or "-BEGIN" for a list
+
+	Value Token // Value is the value of a primary field
+
+	Fields []*Field // Fields are the subfields of a structured field, or the elements of a list
+
+	// Lists are enclosed by BEGIN & END, each followed by the keyword of the list
+	ListKeyword Token // ListKeyword is the keyword following BEGIN
+	End         Token // End is "-END"
+	EndKeyword  Token // EndKeyword is the keyword following END
+}
+
+// Name returns the keyword of the field, such as ARCID, or ADDR for the list "-BEGIN ADDR [...] -END ADDR"
+func (f *Field) Name() string {
+	if f.Kind == parser.List {
+		return f.ListKeyword.Text
+	}
+	return keywordName(f.Keyword.Text)
+}
+
+// keywordName returns the keyword of the text of a keyword token, which may have separators after its hyphen
+func keywordName(text string) string {
+	return strings.TrimLeftFunc(strings.TrimPrefix(text, "-"), lexer.IsSeparator)
+}
+
+// Get returns the first field of the document with the given keyword, or nil if there is none
+func (doc *Document) Get(keyword string) *Field {
+	return get(doc.Fields, keyword)
+}
+
+// Get returns the first subfield or element of the field with the given keyword, or nil if there is none
+func (f *Field) Get(keyword string) *Field {
+	return get(f.Fields, keyword)
+}
+
+// get returns the first of the fields with the given keyword
+func get(fields []*Field, keyword string) *Field {
+	for _, f := range fields {
+		if f.Name() == keyword {
+			return f
+		}
+	}
+	return nil
+}
+
+// NewPrimary returns a primary field, whose tokens are preceded by a space
+func NewPrimary(keyword string, value string) (*Field, error) {
+	if err := lexer.CheckKeyword(keyword); err != nil {
+		return nil, errors.Wrap(err, "NewPrimary")
+	}
+	f := &Field{
+		Kind:    parser.Primary,
+		Keyword: Token{Leading: " ", Text: "-" + keyword},
+		Value:   Token{Leading: " "},
+	}
+	if err := f.SetValue(value); err != nil {
+		return nil, errors.Wrap(err, "NewPrimary")
+	}
+	return f, nil
+}
+
+// SetValue sets the value of a primary field, keeping the separators around it
+func (f *Field) SetValue(value string) error {
+	if f.Kind != parser.Primary {
+		return errors.Errorf("SetValue: field %s is a %s", f.Name(), f.Kind)
+	}
+	if err := lexer.CheckValue(value); err != nil {
+		return errors.Wrap(err, "SetValue")
+	}
+	f.Value.Text = value
+	return nil
+}
+
+// WriteTo writes the document to w, implementing io.WriterTo
+func (doc *Document) WriteTo(w io.Writer) (int64, error) {
+	buf := &bytes.Buffer{}
+	for _, f := range doc.Fields {
+		f.write(buf)
+	}
+	buf.WriteString(doc.Trailing)
+	return buf.WriteTo(w)
+}
+
+// Bytes returns the text of the document
+func (doc *Document) Bytes() []byte {
+	buf := &bytes.Buffer{}
+	doc.WriteTo(buf)
+	return buf.Bytes()
+}
+
+// String returns the text of the document
+func (doc *Document) String() string {
+	return string(doc.Bytes())
+}
+
+// write writes the field to buf
+func (f *Field) write(buf *bytes.Buffer) {
+	f.Keyword.write(buf)
+	switch f.Kind {
+	case parser.Primary:
+		f.Value.write(buf)
+	case parser.List:
+		f.ListKeyword.write(buf)
+	}
+	for _, sub := range f.Fields {
+		sub.write(buf)
+	}
+	if f.Kind == parser.List {
+		f.End.write(buf)
+		f.EndKeyword.write(buf)
+	}
+}
+
+// write writes the token to buf
+func (t Token) write(buf *bytes.Buffer) {
+	buf.WriteString(t.Leading)
+	buf.WriteString(t.Text)
+}
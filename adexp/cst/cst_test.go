@@ -0,0 +1,110 @@
+package cst
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/aabizri/aero/adexp/parser"
+	"github.com/pkg/errors"
+)
+
+const testText = "-TITLE IFPL\r\n-ARCID  AFR 456 \r\n- ADEP LFPG\r\n-GEO -GEOID 01 -LATTD 520000N\t-LONGTD 0150000W\r\n" +
+	"-BEGIN RTEPTS\r\n  -PT -PTID XETBO -FL F350\r\n  -PT -PTID BUBLI\r\n-END RTEPTS\r\n-RMK TCAS (X) A/C\r\n-RMK 8.33\r\n\r\n"
+
+func TestParse(t *testing.T) {
+	doc, err := ParseString(testText)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The document is written back as-is
+	if str := doc.String(); str != testText {
+		t.Errorf("document not written back as-is:\ngot:\n%q\nexpected:\n%q", str, testText)
+	}
+
+	// The tree follows the structure of the document
+	expected := []struct {
+		name   string
+		kind   parser.Kind
+		fields int
+	}{
+		{"TITLE", parser.Primary, 0}, {"ARCID", parser.Primary, 0}, {"ADEP", parser.Primary, 0}, {"GEO", parser.Structured, 3},
+		{"RTEPTS", parser.List, 2}, {"RMK", parser.Primary, 0}, {"RMK", parser.Primary, 0},
+	}
+	if len(doc.Fields) != len(expected) {
+		t.Fatalf("got %d fields, expected %d", len(doc.Fields), len(expected))
+	}
+	for i, e := range expected {
+		f := doc.Fields[i]
+		if f.Name() != e.name || f.Kind != e.kind || len(f.Fields) != e.fields {
+			t.Errorf("field %d: got %s (%s, %d subfields), expected %s (%s, %d subfields)", i, f.Name(), f.Kind, len(f.Fields), e.name, e.kind, e.fields)
+		}
+	}
+	if arcid := doc.Get("ARCID"); arcid.Value.Text != "AFR 456" || arcid.Value.Leading != "  " || arcid.Keyword.Leading != "\r\n" {
+		t.Errorf("unexpected ARCID tokens: %+v", arcid)
+	}
+	if fl := doc.Get("RTEPTS").Fields[0].Get("FL"); fl == nil || fl.Value.Text != "F350" {
+		t.Errorf("unexpected FL of the first point: %+v", fl)
+	}
+	if doc.Trailing != "\r\n\r\n" {
+		t.Errorf("unexpected trailing separators %q", doc.Trailing)
+	}
+}
+
+func TestField_SetValue(t *testing.T) {
+	doc, err := ParseString(testText)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := doc.Get("GEO").Get("LATTD").SetValue("510000N"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rmk, err := NewPrimary("RMK", "NEW")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	doc.Fields = append(doc.Fields, rmk)
+
+	// The new field is written before the trailing separators
+	expected := bytes.Replace([]byte(testText), []byte("520000N"), []byte("510000N"), 1)
+	expected = bytes.Replace(expected, []byte("8.33\r\n"), []byte("8.33 -RMK NEW\r\n"), 1)
+	if got := doc.Bytes(); !bytes.Equal(got, expected) {
+		t.Errorf("got:\n%q\nexpected:\n%q", got, expected)
+	}
+
+	for _, v := range []string{"", "A-B", " AB", "AB\n", "a"} {
+		if err := rmk.SetValue(v); err == nil {
+			t.Errorf("SetValue(%q): expected an error", v)
+		}
+	}
+	if err := doc.Get("GEO").SetValue("X"); err == nil {
+		t.Errorf("expected an error when setting the value of a structured field")
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		text string
+		pos  lexer.Pos
+	}{
+		{"-ARCID AFR456", lexer.Pos{Offset: 0, Line: 1, Column: 1}},
+		{"-TITLE IFPL\n-GEO -ARCID AFR456", lexer.Pos{Offset: 17, Line: 2, Column: 6}},
+		{"-TITLE IFPL\n-BEGIN ADDR -FAC LFPGZQZX -END RTEPTS", lexer.Pos{Offset: 43, Line: 2, Column: 32}},
+	}
+	for _, test := range tests {
+		_, err := ParseString(test.text)
+		le, ok := errors.Cause(err).(*lexer.Error)
+		if !ok {
+			t.Errorf("%q: expected a *lexer.Error, got %v", test.text, err)
+			continue
+		}
+		if le.Pos != test.pos {
+			t.Errorf("%q: got an error at %+v, expected %+v", test.text, le.Pos, test.pos)
+		}
+	}
+	if _, err := ParseString("-TITLE IFPL -BEGIN ADDR -FAC LFPGZQZX"); errors.Cause(err) != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF for an unterminated list, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package cst
+
+import (
+	"io"
+
+	"github.com/aabizri/aero/adexp/catalog"
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/aabizri/aero/adexp/lexer/slicing"
+	"github.com/aabizri/aero/adexp/parser"
+
+	"github.com/pkg/errors"
+)
+
+// item is a lexeme along with its token
+type item struct {
+	kind  lexer.Kind
+	value string
+	pos   lexer.Pos
+	token Token
+}
+
+// cstParser builds the tree from the lexemes of the text, whose offsets give the raw text of the tokens
+type cstParser struct {
+	text   string
+	lexer  lexer.LexScanner
+	end    int   // end is the offset after the last token read
+	peeked *item // peeked is the item read ahead
+}
+
+// Parse parses the document in text, which is copied once.
+// The fields are structured following the catalog, like the parser package does.
+func Parse(text []byte) (*Document, error) {
+	return ParseString(string(text))
+}
+
+// ParseString parses the document in text without copying it, see Parse
+func ParseString(text string) (*Document, error) {
+	cp := &cstParser{
+		text:  text,
+		lexer: slicing.NewString(text),
+	}
+	doc, err := cp.document()
+	if err != nil {
+		return nil, errors.Wrap(err, "Parse")
+	}
+	return doc, nil
+}
+
+// errorAt returns an error located at the given item
+func errorAt(it *item, format string, args ...interface{}) error {
+	return &lexer.Error{Pos: it.pos, Snippet: it.value, Err: errors.Errorf(format, args...)}
+}
+
+// next returns the next item, io.ErrUnexpectedEOF at the end of the input if required
+func (cp *cstParser) next(required bool) (*item, error) {
+	if it := cp.peeked; it != nil {
+		cp.peeked = nil
+		return it, nil
+	}
+
+	lex, err := cp.lexer.ReadLex()
+	if err == io.EOF && required {
+		return nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The keywords start at their hyphen, which may be followed by separators
+	start, end := lex.Pos.Offset, lex.Pos.Offset
+	if lex.Kind == lexer.LexemeBEGIN || lex.Kind == lexer.LexemeEND || (lex.Kind == lexer.LexemeKeyword && cp.text[start] == '-') {
+		for end++; lexer.IsSeparator(rune(cp.text[end])); end++ {
+		}
+	}
+	end += len(lex.Value)
+
+	it := &item{
+		kind:  lex.Kind,
+		value: lex.Value,
+		pos:   lex.Pos,
+		token: Token{Leading: cp.text[cp.end:start], Text: cp.text[start:end]},
+	}
+	cp.end = end
+	return it, nil
+}
+
+// backup makes the item the next one
+func (cp *cstParser) backup(it *item) {
+	cp.peeked = it
+}
+
+// document parses the whole document, which starts with TITLE
+func (cp *cstParser) document() (*Document, error) {
+	doc := &Document{}
+	for {
+		it, err := cp.next(false)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if len(doc.Fields) == 0 && (it.kind != lexer.LexemeKeyword || it.value != parser.TITLEKeyword) {
+			return nil, errorAt(it, "document: first field encountered is not \"%s\" but \"%s\"", parser.TITLEKeyword, it.value)
+		}
+
+		f, err := cp.field(it)
+		if err != nil {
+			return nil, err
+		}
+		doc.Fields = append(doc.Fields, f)
+	}
+	doc.Trailing = cp.text[cp.end:]
+	return doc, nil
+}
+
+// field parses a field starting with the keyword or BEGIN item it
+func (cp *cstParser) field(it *item) (*Field, error) {
+	switch it.kind {
+	case lexer.LexemeBEGIN:
+		return cp.list(it)
+	case lexer.LexemeKeyword:
+	default:
+		return nil, errorAt(it, "field: expected a keyword, got a %s instead", it.kind)
+	}
+
+	f := &Field{Keyword: it.token}
+	keyword := it.value
+	it, err := cp.next(true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "field %s", keyword)
+	}
+	switch {
+	case it.kind == lexer.LexemeValue:
+		f.Kind = parser.Primary
+		f.Value = it.token
+		return f, nil
+
+	case it.kind == lexer.LexemeKeyword && catalog.Allowed(keyword, it.value):
+		f.Kind = parser.Structured
+		for it.kind == lexer.LexemeKeyword && catalog.Allowed(keyword, it.value) {
+			sub, err := cp.field(it)
+			if err != nil {
+				return nil, errors.Wrapf(err, "field %s", keyword)
+			}
+			f.Fields = append(f.Fields, sub)
+
+			// The structured field is over at the first lexeme that isn't one of its subfields
+			it, err = cp.next(false)
+			if err == io.EOF {
+				return f, nil
+			} else if err != nil {
+				return nil, errors.Wrapf(err, "field %s", keyword)
+			}
+		}
+		cp.backup(it)
+		return f, nil
+
+	case it.kind == lexer.LexemeKeyword:
+		return nil, errorAt(it, "field: keyword \"%s\" followed by keyword \"%s\" which isn't one of its subfields", keyword, it.value)
+
+	default:
+		return nil, errorAt(it, "field: unexpected %s as value of %s", it.kind, keyword)
+	}
+}
+
+// list parses a list starting with the BEGIN item it
+func (cp *cstParser) list(it *item) (*Field, error) {
+	f := &Field{Kind: parser.List, Keyword: it.token}
+	it, err := cp.next(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "list")
+	}
+	if it.kind != lexer.LexemeKeyword {
+		return nil, errorAt(it, "list: expected a keyword following a BEGIN, got a %s instead", it.kind)
+	}
+	f.ListKeyword = it.token
+	keyword := it.value
+
+	// The elements, until END
+	for {
+		it, err = cp.next(true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list %s", keyword)
+		}
+		if it.kind == lexer.LexemeEND {
+			break
+		}
+		elem, err := cp.field(it)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list %s", keyword)
+		}
+		f.Fields = append(f.Fields, elem)
+	}
+	f.End = it.token
+
+	it, err = cp.next(true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s", keyword)
+	}
+	if it.kind != lexer.LexemeKeyword || it.value != keyword {
+		return nil, errorAt(it, "list: list's associated keyword not consistent (BEGIN has %s , END has %s)", keyword, it.value)
+	}
+	f.EndKeyword = it.token
+	return f, nil
+}
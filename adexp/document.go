@@ -0,0 +1,139 @@
+package adexp
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+// A Field is a field of a Document: a keyword and its value
+type Field struct {
+	Keyword string
+	value
+}
+
+// Kind returns the kind of the field
+func (f Field) Kind() Kind {
+	return f.kind
+}
+
+// Primary returns the value of a primary field.
+// If the field is of another kind, ok returns as false.
+func (f Field) Primary() (val string, ok bool) {
+	if f.kind != Primary {
+		return "", false
+	}
+	val, ok = f.value.value.(string)
+	return val, ok
+}
+
+// Structured returns the subfields of a structured field.
+// If the field is of another kind, ok returns as false.
+// The field returned is a copy, changing it doesn't alter the document.
+func (f Field) Structured() (val *Multi, ok bool) {
+	if f.kind != Structured {
+		return nil, false
+	}
+	mul, ok := f.value.value.(Multi)
+	if !ok {
+		return nil, false
+	}
+	return detached(mul), true
+}
+
+// List returns the elements of a list field.
+// If the field is of another kind, ok returns as false.
+// The field returned is a copy, changing it doesn't alter the document.
+func (f Field) List() (val *Multi, ok bool) {
+	if f.kind != List {
+		return nil, false
+	}
+	mul, ok := f.value.value.(Multi)
+	if !ok {
+		return nil, false
+	}
+	return detached(mul), true
+}
+
+// A Document is an ADEXP message keeping its fields in order, repeated keywords included.
+// The subfields of its structured fields and the elements of its list fields are kept so too.
+//
+// The ADEXP map is a convenience view of a document, see Map.
+type Document []Field
+
+// All returns the fields with the given keyword, in order
+func (doc Document) All(keyword string) []Field {
+	var fields []Field
+	for _, f := range doc {
+		if f.Keyword == keyword {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// First returns the first field with the given keyword
+func (doc Document) First(keyword string) (f Field, ok bool) {
+	for _, f := range doc {
+		if f.Keyword == keyword {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// Range calls f for each field, in order, until f returns false
+func (doc Document) Range(f func(field Field) bool) {
+	for _, field := range doc {
+		if !f(field) {
+			return
+		}
+	}
+}
+
+// Map returns the document as an ADEXP map.
+// Of the fields sharing a keyword, the map keeps the last one, as Decode does.
+func (doc Document) Map() ADEXP {
+	msg := make(ADEXP, len(doc))
+	for _, f := range doc {
+		msg[f.Keyword] = f.value
+	}
+	return msg
+}
+
+// Document returns the fields of the message as a document, in the order of the Encoder: TITLE first and then by keyword
+func (msg ADEXP) Document() Document {
+	doc := make(Document, 0, len(msg))
+	for _, k := range sortedKeys(msg) {
+		doc = append(doc, Field{Keyword: k, value: msg[k]})
+	}
+	return doc
+}
+
+// MarshalText marshals the document, keeping the order of its fields
+func (doc Document) MarshalText() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	err := NewEncoder(buf).EncodeDocument(doc)
+	return buf.Bytes(), err
+}
+
+// UnmarshalText unmarshals the first message of the text to the document, replacing its fields.
+// A text without any message results in an empty document.
+func (doc *Document) UnmarshalText(text []byte) error {
+	*doc = nil
+	return noMessage(NewDecoder(bytes.NewReader(text)).DecodeDocument(doc))
+}
+
+// EncodeDocument encodes the document, each field on its own line and in order.
+// Nothing is written if the document cannot be encoded.
+func (enc *Encoder) EncodeDocument(doc Document) error {
+	buf := &bytes.Buffer{}
+	for i, f := range doc {
+		if err := enc.encodeField(buf, f.Keyword, f.value, 0); err != nil {
+			return errors.Wrapf(err, "EncodeDocument: error while encoding field #%d (%s)", i, f.Keyword)
+		}
+	}
+
+	_, err := buf.WriteTo(enc.writer)
+	return err
+}
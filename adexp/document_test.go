@@ -0,0 +1,111 @@
+package adexp
+
+import (
+	"strings"
+	"testing"
+)
+
+const testDocument = "-TITLE IFPL -RMK FIRST -GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W -ARCID AFR456 " +
+	"-GEO -GEOID 02 -LATTD 510000N -LONGTD 0140000W -BEGIN ADDR -FAC LFPGZQZX -FAC EGLLZQZX -END ADDR -RMK SECOND"
+
+func TestDecoder_DecodeDocument(t *testing.T) {
+	var doc Document
+	if err := NewDecoder(strings.NewReader(testDocument)).DecodeDocument(&doc); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	// The fields are kept in order
+	var keywords []string
+	for _, f := range doc {
+		keywords = append(keywords, f.Keyword)
+	}
+	if str := strings.Join(keywords, " "); str != "TITLE RMK GEO ARCID GEO ADDR RMK" {
+		t.Errorf("unexpected fields %s", str)
+	}
+
+	// Repeated keywords
+	rmks := doc.All("RMK")
+	if len(rmks) != 2 {
+		t.Fatalf("expected 2 RMK, got %d", len(rmks))
+	}
+	if rmk, _ := rmks[1].Primary(); rmk != "SECOND" {
+		t.Errorf("unexpected second RMK %q", rmk)
+	}
+	geo, ok := doc.First("GEO")
+	if !ok {
+		t.Fatalf("GEO not found")
+	}
+	if mul, ok := geo.Structured(); !ok {
+		t.Errorf("GEO isn't structured")
+	} else if id, _ := mul.GetPrimary("GEOID"); id != "01" {
+		t.Errorf("unexpected GEOID of the first GEO %q", id)
+	}
+	if _, ok := geo.Primary(); ok {
+		t.Errorf("GEO returned as a primary field")
+	}
+	if _, ok := doc.First("EOBT"); ok {
+		t.Errorf("EOBT found")
+	}
+
+	// The map keeps the last of the repeated fields, as Decode does
+	msg := ADEXP{}
+	if err := NewDecoder(strings.NewReader(testDocument)).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	if view := doc.Map(); len(view) != len(msg) {
+		t.Errorf("map view of %d fields, expected %d", len(view), len(msg))
+	} else if rmk, _ := view.GetPrimary("RMK"); rmk != "SECOND" {
+		t.Errorf("unexpected RMK %q in the map view", rmk)
+	}
+}
+
+func TestDocument_MarshalText(t *testing.T) {
+	var doc Document
+	if err := doc.UnmarshalText([]byte(testDocument)); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	text, err := doc.MarshalText()
+	if err != nil {
+		t.Fatalf("error while marshalling: %v", err)
+	}
+
+	// The fields are written in order, and read back the same
+	if i, j := strings.Index(string(text), "-RMK FIRST"), strings.Index(string(text), "-RMK SECOND"); i == -1 || j < i {
+		t.Errorf("RMK not written in order:\n%s", text)
+	}
+	var again Document
+	if err := again.UnmarshalText(text); err != nil {
+		t.Fatalf("error while unmarshalling the marshalled document: %v", err)
+	}
+	if len(again) != len(doc) {
+		t.Errorf("got %d fields back, expected %d", len(again), len(doc))
+	}
+}
+
+func TestDecoder_DecodeDocument_Subfields(t *testing.T) {
+	var doc Document
+	if err := doc.UnmarshalText([]byte("-TITLE IFPL -GEO -LONGTD 0150000W -GEOID 01 -LATTD 520000N -GEOID 02")); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	geo, ok := doc[1].Structured()
+	if !ok {
+		t.Fatalf("GEO isn't structured")
+	}
+
+	// The subfields keep their order, repeated ones included, the first of them being accessible by keyword
+	if keys := strings.Join(geo.Keys(), " "); keys != "LONGTD GEOID LATTD GEOID" {
+		t.Errorf("unexpected subfields %s", keys)
+	}
+	if id, _ := geo.GetPrimary("GEOID"); id != "01" {
+		t.Errorf("expected the first GEOID, got %q", id)
+	}
+
+	// And are written back so
+	text, err := doc.MarshalText()
+	if err != nil {
+		t.Fatalf("error while marshalling: %v", err)
+	}
+	if i, j := strings.Index(string(text), "-GEOID 01"), strings.Index(string(text), "-GEOID 02"); i == -1 || j < i {
+		t.Errorf("GEOID not written in order:\n%s", text)
+	}
+}
@@ -0,0 +1,297 @@
+package adexp
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+// A Capability is an equipment or capability of an aircraft, as given by CEQPT (ICAO field 10a) or SEQPT (ICAO field 10b)
+type Capability uint8
+
+// These are the radiocommunication, navigation and approach aid equipment and capabilities of CEQPT
+const (
+	GBAS                    Capability = iota // A: GBAS landing system
+	LPV                                       // B: LPV (APV with SBAS)
+	LORANC                                    // C: LORAN C
+	DME                                       // D: DME
+	ACARSFMCWPR                               // E1: FMC WPR ACARS
+	ACARSDFIS                                 // E2: D-FIS ACARS
+	ACARSPDC                                  // E3: PDC ACARS
+	ADF                                       // F: ADF
+	GNSS                                      // G: GNSS
+	HFRTF                                     // H: HF RTF
+	InertialNavigation                        // I: Inertial navigation
+	CPDLCATNVDL2                              // J1: CPDLC ATN VDL Mode 2
+	CPDLCFANSHFDL                             // J2: CPDLC FANS 1/A HFDL
+	CPDLCFANSVDLA                             // J3: CPDLC FANS 1/A VDL Mode A
+	CPDLCFANSVDL2                             // J4: CPDLC FANS 1/A VDL Mode 2
+	CPDLCFANSSATCOMINMARSAT                   // J5: CPDLC FANS 1/A SATCOM (INMARSAT)
+	CPDLCFANSSATCOMMTSAT                      // J6: CPDLC FANS 1/A SATCOM (MTSAT)
+	CPDLCFANSSATCOMIridium                    // J7: CPDLC FANS 1/A SATCOM (Iridium)
+	MLS                                       // K: MLS
+	ILS                                       // L: ILS
+	RTFSATCOMINMARSAT                         // M1: ATC RTF SATCOM (INMARSAT)
+	RTFMTSAT                                  // M2: ATC RTF (MTSAT)
+	RTFIridium                                // M3: ATC RTF (Iridium)
+	VOR                                       // O: VOR
+	RCP1                                      // P1 to P9: reserved for RCP
+	RCP2
+	RCP3
+	RCP4
+	RCP5
+	RCP6
+	RCP7
+	RCP8
+	RCP9
+	PBN               // R: PBN approved
+	TACAN             // T: TACAN
+	UHFRTF            // U: UHF RTF
+	VHFRTF            // V: VHF RTF
+	RVSM              // W: RVSM approved
+	MNPS              // X: MNPS approved
+	VHF833            // Y: VHF with 8.33 kHz channel spacing
+	OtherAidEquipment // Z: Other equipment carried or other capabilities
+
+	// surveillanceStart is the first of the surveillance capabilities
+	surveillanceStart
+)
+
+// These are the surveillance equipment and capabilities of SEQPT
+const (
+	ModeA         Capability = iota + surveillanceStart // A: Transponder Mode A
+	ModeC                                               // C: Transponder Mode A & C
+	ModeSE                                              // E: Transponder Mode S, with aircraft identification, pressure-altitude and extended squitter
+	ModeSH                                              // H: Transponder Mode S, with aircraft identification, pressure-altitude and enhanced surveillance
+	ModeSI                                              // I: Transponder Mode S, with aircraft identification but no pressure-altitude
+	ModeSL                                              // L: Transponder Mode S, with aircraft identification, pressure-altitude, extended squitter and enhanced surveillance
+	ModeSP                                              // P: Transponder Mode S, with pressure-altitude but no aircraft identification
+	ModeSS                                              // S: Transponder Mode S, with pressure-altitude and aircraft identification
+	ModeSX                                              // X: Transponder Mode S, with neither aircraft identification nor pressure-altitude
+	ADSB1090Out                                         // B1: ADS-B "out" on 1090 MHz
+	ADSB1090OutIn                                       // B2: ADS-B "out" and "in" on 1090 MHz
+	ADSBUATOut                                          // U1: ADS-B "out" using UAT
+	ADSBUATOutIn                                        // U2: ADS-B "out" and "in" using UAT
+	ADSBVDL4Out                                         // V1: ADS-B "out" using VDL Mode 4
+	ADSBVDL4OutIn                                       // V2: ADS-B "out" and "in" using VDL Mode 4
+	ADSCFANS                                            // D1: ADS-C with FANS 1/A
+	ADSCATN                                             // G1: ADS-C with ATN
+
+	// capabilityCount is the number of capabilities
+	capabilityCount
+)
+
+// designators are the ICAO designators of the capabilities, in order
+var designators = [capabilityCount]string{
+	"A", "B", "C", "D", "E1", "E2", "E3", "F", "G", "H", "I", "J1", "J2", "J3", "J4", "J5", "J6", "J7", "K", "L", "M1", "M2", "M3", "O",
+	"P1", "P2", "P3", "P4", "P5", "P6", "P7", "P8", "P9", "R", "T", "U", "V", "W", "X", "Y", "Z",
+	"A", "C", "E", "H", "I", "L", "P", "S", "X", "B1", "B2", "U1", "U2", "V1", "V2", "D1", "G1",
+}
+
+// String returns the ICAO designator of the capability, such as W for RVSM
+func (c Capability) String() string {
+	if c >= capabilityCount {
+		return "!(unknown capability)"
+	}
+	return designators[c]
+}
+
+// Capabilities is a set of capabilities
+type Capabilities uint64
+
+// standardEquipment are the capabilities designated by S in CEQPT
+const standardEquipment = 1<<VHFRTF | 1<<VOR | 1<<ILS
+
+// Has returns true if all the given capabilities are in the set
+func (cs Capabilities) Has(c ...Capability) bool {
+	for _, one := range c {
+		if cs&(1<<one) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny returns true if any of the given capabilities is in the set
+func (cs Capabilities) HasAny(c ...Capability) bool {
+	for _, one := range c {
+		if cs&(1<<one) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds capabilities to the set
+func (cs *Capabilities) Add(c ...Capability) {
+	for _, one := range c {
+		*cs |= 1 << one
+	}
+}
+
+// Remove removes capabilities from the set
+func (cs *Capabilities) Remove(c ...Capability) {
+	for _, one := range c {
+		*cs &^= 1 << one
+	}
+}
+
+// List returns the capabilities in the set, in order
+func (cs Capabilities) List() []Capability {
+	var list []Capability
+	for c := Capability(0); c < capabilityCount; c++ {
+		if cs.Has(c) {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+// HasRVSM returns true if the aircraft is RVSM approved (W)
+func (cs Capabilities) HasRVSM() bool {
+	return cs.Has(RVSM)
+}
+
+// Has833 returns true if the aircraft has a VHF radio with 8.33 kHz channel spacing (Y)
+func (cs Capabilities) Has833() bool {
+	return cs.Has(VHF833)
+}
+
+// HasPBN returns true if the aircraft is PBN approved (R), the details being given by the PBN field
+func (cs Capabilities) HasPBN() bool {
+	return cs.Has(PBN)
+}
+
+// HasCPDLC returns true if the aircraft has any CPDLC capability (J1 to J7)
+func (cs Capabilities) HasCPDLC() bool {
+	return cs.HasAny(CPDLCATNVDL2, CPDLCFANSHFDL, CPDLCFANSVDLA, CPDLCFANSVDL2, CPDLCFANSSATCOMINMARSAT, CPDLCFANSSATCOMMTSAT, CPDLCFANSSATCOMIridium)
+}
+
+// HasModeS returns true if the aircraft has a Mode S transponder (E, H, I, L, P, S or X)
+func (cs Capabilities) HasModeS() bool {
+	return cs.HasAny(ModeSE, ModeSH, ModeSI, ModeSL, ModeSP, ModeSS, ModeSX)
+}
+
+// HasADSB returns true if the aircraft has any ADS-B capability (B1, B2, U1, U2, V1 or V2)
+func (cs Capabilities) HasADSB() bool {
+	return cs.HasAny(ADSB1090Out, ADSB1090OutIn, ADSBUATOut, ADSBUATOutIn, ADSBVDL4Out, ADSBVDL4OutIn)
+}
+
+// HasADSC returns true if the aircraft has any ADS-C capability (D1 or G1)
+func (cs Capabilities) HasADSC() bool {
+	return cs.HasAny(ADSCFANS, ADSCATN)
+}
+
+// ParseCapabilities parses the content of CEQPT & SEQPT, such as "SDE2FGHIRWY" & "LB1".
+// S stands for VHF RTF, VOR & ILS, and N for no equipment.
+func ParseCapabilities(ceqpt string, seqpt string) (Capabilities, error) {
+	var cs Capabilities
+	if ceqpt != "N" {
+		if len(ceqpt) > 0 && ceqpt[0] == 'S' {
+			cs |= standardEquipment
+			ceqpt = ceqpt[1:]
+		}
+		if err := cs.parse(ceqpt, 0, surveillanceStart); err != nil {
+			return 0, errors.Wrap(err, "ParseCapabilities: CEQPT")
+		}
+	}
+	if seqpt != "N" {
+		if err := cs.parse(seqpt, surveillanceStart, capabilityCount); err != nil {
+			return 0, errors.Wrap(err, "ParseCapabilities: SEQPT")
+		}
+	}
+	return cs, nil
+}
+
+// parse adds the capabilities whose designators are in str, looking them up among the capabilities [from, to)
+func (cs *Capabilities) parse(str string, from Capability, to Capability) error {
+	for i := 0; i < len(str); {
+		found := false
+		// Two-character designators first, as B1 is not B followed by 1
+		for _, n := range []int{2, 1} {
+			if i+n > len(str) {
+				continue
+			}
+			for c := from; c < to; c++ {
+				if designators[c] == str[i:i+n] {
+					cs.Add(c)
+					i += n
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("unknown designator at %q", str[i:])
+		}
+	}
+	return nil
+}
+
+// format returns the designators of the capabilities [from, to) of the set, in order
+func (cs Capabilities) format(from Capability, to Capability) string {
+	b := &bytes.Buffer{}
+	for c := from; c < to; c++ {
+		if cs.Has(c) {
+			b.WriteString(designators[c])
+		}
+	}
+	return b.String()
+}
+
+// CEQPT returns the canonical content of CEQPT for the set, S replacing VHF RTF, VOR & ILS, and N standing for none
+func (cs Capabilities) CEQPT() string {
+	var prefix string
+	if cs.Has(VHFRTF, VOR, ILS) {
+		prefix = "S"
+		cs &^= standardEquipment
+	}
+	if str := prefix + cs.format(0, surveillanceStart); str != "" {
+		return str
+	}
+	return "N"
+}
+
+// SEQPT returns the canonical content of SEQPT for the set, N standing for none
+func (cs Capabilities) SEQPT() string {
+	if str := cs.format(surveillanceStart, capabilityCount); str != "" {
+		return str
+	}
+	return "N"
+}
+
+// String returns the capabilities as in ICAO field 10, such as SDE2FGHIRWY/LB1
+func (cs Capabilities) String() string {
+	return cs.CEQPT() + "/" + cs.SEQPT()
+}
+
+// GetCapabilities returns the capabilities given by the CEQPT & SEQPT primary fields, SEQPT being N if absent
+func (msg ADEXP) GetCapabilities() (Capabilities, error) {
+	ceqpt, err := getPrimary(msg, "CEQPT")
+	if err != nil {
+		return 0, errors.Wrap(err, "GetCapabilities")
+	}
+	seqpt, ok := msg.GetPrimary("SEQPT")
+	if !ok {
+		seqpt = "N"
+	}
+	cs, err := ParseCapabilities(ceqpt, seqpt)
+	if err != nil {
+		return 0, errors.Wrap(err, "GetCapabilities")
+	}
+	return cs, nil
+}
+
+// SetCapabilities sets the CEQPT & SEQPT primary fields to the canonical designators of the capabilities
+func (msg ADEXP) SetCapabilities(cs Capabilities) error {
+	if err := msg.SetPrimary("CEQPT", cs.CEQPT()); err != nil {
+		return errors.Wrap(err, "SetCapabilities")
+	}
+	if err := msg.SetPrimary("SEQPT", cs.SEQPT()); err != nil {
+		return errors.Wrap(err, "SetCapabilities")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package adexp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseCapabilities(t *testing.T) {
+	cs, err := ParseCapabilities("SDE2FGHIJ1RWY", "LB1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cs.Has(VHFRTF, VOR, ILS, DME, ACARSDFIS, ADF, GNSS, HFRTF, InertialNavigation, CPDLCATNVDL2, PBN, RVSM, VHF833, ModeSL, ADSB1090Out) {
+		t.Errorf("missing capabilities in %v", cs.List())
+	}
+	if cs.Has(GBAS) || cs.Has(ModeA) || cs.Has(ADSB1090OutIn) {
+		t.Errorf("unexpected capabilities in %v", cs.List())
+	}
+	if !cs.HasRVSM() || !cs.Has833() || !cs.HasPBN() || !cs.HasCPDLC() || !cs.HasModeS() || !cs.HasADSB() || cs.HasADSC() {
+		t.Errorf("unexpected helpers for %v", cs)
+	}
+	if len(cs.List()) != 15 {
+		t.Errorf("expected 15 capabilities, got %v", cs.List())
+	}
+
+	// Canonical form
+	tests := []struct {
+		ceqpt, seqpt string
+		expected     string
+	}{
+		{"SDE2FGHIJ1RWY", "LB1", "SDE2FGHIJ1RWY/LB1"},
+		{"SWYOVL", "B1L", "SWY/LB1"},
+		{"VO", "C", "OV/C"},
+		{"N", "N", "N/N"},
+	}
+	for _, test := range tests {
+		cs, err := ParseCapabilities(test.ceqpt, test.seqpt)
+		if err != nil {
+			t.Errorf("%s/%s: unexpected error: %v", test.ceqpt, test.seqpt, err)
+			continue
+		}
+		if s := cs.String(); s != test.expected {
+			t.Errorf("%s/%s: got %q, expected %q", test.ceqpt, test.seqpt, s, test.expected)
+		}
+	}
+
+	for _, eq := range [][2]string{{"SQ", "N"}, {"J8", "N"}, {"S", "B3"}, {"NW", "N"}, {"S", "Z"}} {
+		if _, err := ParseCapabilities(eq[0], eq[1]); err == nil {
+			t.Errorf("%s/%s: expected an error", eq[0], eq[1])
+		}
+	}
+}
+
+func TestCapabilities_AddRemove(t *testing.T) {
+	var cs Capabilities
+	cs.Add(RVSM, ADSCFANS)
+	cs.Remove(RVSM)
+	if cs.HasRVSM() || !cs.HasADSC() {
+		t.Errorf("unexpected capabilities %v", cs.List())
+	}
+	if s := ADSCFANS.String(); s != "D1" {
+		t.Errorf("unexpected designator %q", s)
+	}
+}
+
+func TestADEXP_GetCapabilities(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE IFPL -CEQPT SDFGRWY -SEQPT SB2")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	cs, err := msg.GetCapabilities()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cs.HasRVSM() || !cs.Has(ModeSS, ADSB1090OutIn) {
+		t.Errorf("unexpected capabilities %v", cs)
+	}
+
+	cs.Remove(RVSM)
+	cs.Add(CPDLCATNVDL2)
+	if err := msg.SetCapabilities(cs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ceqpt, _ := msg.GetPrimary("CEQPT"); ceqpt != "SDFGJ1RY" {
+		t.Errorf("unexpected CEQPT %q", ceqpt)
+	}
+
+	if _, err := (ADEXP{}).GetCapabilities(); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound without CEQPT, got %v", err)
+	}
+}
@@ -0,0 +1,295 @@
+package adexp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// A Position is a geographical position, in decimal degrees
+type Position struct {
+	Latitude  float64 // Latitude is positive to the north
+	Longitude float64 // Longitude is positive to the east
+}
+
+// ParseLatitude parses a latitude, as DDMMSS followed by N or S as in LATTD.
+// The shorter forms DD and DDMM, as used in ICAO routes, are accepted too.
+func ParseLatitude(str string) (float64, error) {
+	deg, err := parseCoordinate(str, 2, 'N', 'S')
+	if err != nil {
+		return 0, errors.Wrapf(err, "ParseLatitude: invalid latitude %q", str)
+	} else if deg < -90 || deg > 90 {
+		return 0, errors.Errorf("ParseLatitude: latitude %q out of range", str)
+	}
+	return deg, nil
+}
+
+// ParseLongitude parses a longitude, as DDDMMSS followed by E or W as in LONGTD.
+// The shorter forms DDD and DDDMM, as used in ICAO routes, are accepted too.
+func ParseLongitude(str string) (float64, error) {
+	deg, err := parseCoordinate(str, 3, 'E', 'W')
+	if err != nil {
+		return 0, errors.Wrapf(err, "ParseLongitude: invalid longitude %q", str)
+	} else if deg < -180 || deg > 180 {
+		return 0, errors.Errorf("ParseLongitude: longitude %q out of range", str)
+	}
+	return deg, nil
+}
+
+// parseCoordinate parses degrees of degDigits digits, optionally followed by minutes & seconds, and by the side
+func parseCoordinate(str string, degDigits int, positive byte, negative byte) (float64, error) {
+	if len(str) == 0 {
+		return 0, errors.New("empty coordinate")
+	}
+	digits, side := str[:len(str)-1], str[len(str)-1]
+	if side != positive && side != negative {
+		return 0, errors.Errorf("expected %c or %c as the last character", positive, negative)
+	}
+	if len(digits) != degDigits && len(digits) != degDigits+2 && len(digits) != degDigits+4 {
+		return 0, errors.Errorf("expected %d, %d or %d digits", degDigits, degDigits+2, degDigits+4)
+	}
+
+	deg, err := parseDigits(digits[:degDigits], degDigits)
+	if err != nil {
+		return 0, err
+	}
+	var minSec [2]int
+	for i := 0; degDigits+2*i < len(digits); i++ {
+		n, err := parseDigits(digits[degDigits+2*i:degDigits+2*i+2], 2)
+		if err != nil {
+			return 0, err
+		} else if n > 59 {
+			return 0, errors.Errorf("%d minutes or seconds is out of range", n)
+		}
+		minSec[i] = n
+	}
+
+	val := float64(deg) + float64(minSec[0])/60 + float64(minSec[1])/3600
+	if side == negative {
+		val = -val
+	}
+	return val, nil
+}
+
+// FormatLatitude formats a latitude as in LATTD, such as 520000N
+func FormatLatitude(lat float64) string {
+	return formatCoordinate(lat, 2, 'N', 'S')
+}
+
+// FormatLongitude formats a longitude as in LONGTD, such as 0150000W
+func FormatLongitude(long float64) string {
+	return formatCoordinate(long, 3, 'E', 'W')
+}
+
+// formatCoordinate formats decimal degrees to the nearest second
+func formatCoordinate(deg float64, degDigits int, positive byte, negative byte) string {
+	side := positive
+	if deg < 0 {
+		side, deg = negative, -deg
+	}
+	secs := int(math.Floor(deg*3600 + 0.5))
+	return fmt.Sprintf("%0*d%02d%02d%c", degDigits, secs/3600, secs/60%60, secs%60, side)
+}
+
+// ParsePosition parses a latitude immediately followed by a longitude, such as 52N015W, 5230N01530W or 520000N0150000W
+func ParsePosition(str string) (Position, error) {
+	i := 0
+	for i < len(str) && str[i] != 'N' && str[i] != 'S' {
+		i++
+	}
+	if i == len(str) {
+		return Position{}, errors.Errorf("ParsePosition: no latitude in %q", str)
+	}
+	lat, err := ParseLatitude(str[:i+1])
+	if err != nil {
+		return Position{}, errors.Wrap(err, "ParsePosition")
+	}
+	long, err := ParseLongitude(str[i+1:])
+	if err != nil {
+		return Position{}, errors.Wrap(err, "ParsePosition")
+	}
+	return Position{Latitude: lat, Longitude: long}, nil
+}
+
+// String returns the position as a latitude immediately followed by a longitude, such as 520000N0150000W
+func (p Position) String() string {
+	return FormatLatitude(p.Latitude) + FormatLongitude(p.Longitude)
+}
+
+// earthRadius is the mean radius of the Earth, in nautical miles
+const earthRadius = 3440.065
+
+// DistanceTo returns the great-circle distance to another position, in nautical miles
+func (p Position) DistanceTo(other Position) float64 {
+	lat1, lat2 := radians(p.Latitude), radians(other.Latitude)
+	dLat, dLong := lat2-lat1, radians(other.Longitude-p.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Destination returns the position at the given bearing, in degrees, and distance, in nautical miles, along a great circle
+func (p Position) Destination(bearing float64, distance float64) Position {
+	lat1, long1 := radians(p.Latitude), radians(p.Longitude)
+	brng, d := radians(bearing), distance/earthRadius
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(brng))
+	long2 := long1 + math.Atan2(math.Sin(brng)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return Position{
+		Latitude:  degrees(lat2),
+		Longitude: math.Mod(degrees(long2)+540, 360) - 180,
+	}
+}
+
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+func degrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// A GeoPoint is a point defined by its coordinates, as in the GEO field
+type GeoPoint struct {
+	ID string // ID is the name given to the point, such as GEO01
+	Position
+}
+
+// A RefPoint is a point defined by a bearing & distance from another point, as in the REF field
+type RefPoint struct {
+	ID       string // ID is the name given to the point, such as REF01
+	PointID  string // PointID is the point of reference
+	Bearing  int    // Bearing is in degrees magnetic
+	Distance int    // Distance is in nautical miles
+}
+
+// From returns the position of the point, given the position of its point of reference
+// and the magnetic variation there, in degrees, positive when east.
+func (rp RefPoint) From(origin Position, variation float64) Position {
+	return origin.Destination(float64(rp.Bearing)+variation, float64(rp.Distance))
+}
+
+// GetPosition returns the position held by the LATTD & LONGTD subfields, as in a GEO field
+func (mul *Multi) GetPosition() (Position, error) {
+	lattd, err := getPrimary(mul, "LATTD")
+	if err != nil {
+		return Position{}, errors.Wrap(err, "GetPosition")
+	}
+	longtd, err := getPrimary(mul, "LONGTD")
+	if err != nil {
+		return Position{}, errors.Wrap(err, "GetPosition")
+	}
+
+	var p Position
+	if p.Latitude, err = ParseLatitude(lattd); err != nil {
+		return Position{}, errors.Wrap(err, "GetPosition")
+	}
+	if p.Longitude, err = ParseLongitude(longtd); err != nil {
+		return Position{}, errors.Wrap(err, "GetPosition")
+	}
+	return p, nil
+}
+
+// GetGeoPoint returns the point held by the GEOID, LATTD & LONGTD subfields of a GEO field
+func (mul *Multi) GetGeoPoint() (GeoPoint, error) {
+	id, err := getPrimary(mul, "GEOID")
+	if err != nil {
+		return GeoPoint{}, errors.Wrap(err, "GetGeoPoint")
+	}
+	p, err := mul.GetPosition()
+	if err != nil {
+		return GeoPoint{}, errors.Wrapf(err, "GetGeoPoint: point %s", id)
+	}
+	return GeoPoint{ID: id, Position: p}, nil
+}
+
+// GetRefPoint returns the point held by the REFID, PTID, BRNG & DISTNC subfields of a REF field
+func (mul *Multi) GetRefPoint() (RefPoint, error) {
+	var (
+		rp  RefPoint
+		err error
+	)
+	if rp.ID, err = getPrimary(mul, "REFID"); err != nil {
+		return RefPoint{}, errors.Wrap(err, "GetRefPoint")
+	}
+	if rp.PointID, err = getPrimary(mul, "PTID"); err != nil {
+		return RefPoint{}, errors.Wrapf(err, "GetRefPoint: point %s", rp.ID)
+	}
+	for _, f := range []struct {
+		keyword string
+		dst     *int
+	}{
+		{"BRNG", &rp.Bearing},
+		{"DISTNC", &rp.Distance},
+	} {
+		str, err := getPrimary(mul, f.keyword)
+		if err != nil {
+			return RefPoint{}, errors.Wrapf(err, "GetRefPoint: point %s", rp.ID)
+		}
+		if *f.dst, err = strconv.Atoi(str); err != nil {
+			return RefPoint{}, errors.Wrapf(err, "GetRefPoint: point %s: invalid %s", rp.ID, f.keyword)
+		}
+	}
+	return rp, nil
+}
+
+// GetGeoPoint returns the point held by the structured field associated with the key, usually GEO
+func (msg ADEXP) GetGeoPoint(key string) (GeoPoint, error) {
+	mul, ok := msg.GetStructured(key)
+	if !ok {
+		return GeoPoint{}, errors.Wrapf(ErrFieldNotFound, "GetGeoPoint: no structured field %s", key)
+	}
+	return mul.GetGeoPoint()
+}
+
+// GetRefPoint returns the point held by the structured field associated with the key, usually REF
+func (msg ADEXP) GetRefPoint(key string) (RefPoint, error) {
+	mul, ok := msg.GetStructured(key)
+	if !ok {
+		return RefPoint{}, errors.Wrapf(ErrFieldNotFound, "GetRefPoint: no structured field %s", key)
+	}
+	return mul.GetRefPoint()
+}
+
+// PointPosition returns the position of a point named in the message, such as the PTID of a PT.
+// Points defined by a GEO field are returned as-is, and those defined by a REF field are computed from their point of reference when it is itself defined by a GEO field.
+// Named points, such as navigation aids, can't be resolved and return an error caused by ErrFieldNotFound.
+//
+// The magnetic bearings of the REF fields are converted to true ones with the given variation, see RefPoint.From.
+//
+// As a message only holds one GEO and one REF field, only these two points can be resolved, see Document.PointPosition.
+func (msg ADEXP) PointPosition(id string, variation float64) (Position, error) {
+	return msg.Document().PointPosition(id, variation)
+}
+
+// PointPosition returns the position of a point named in the document, resolved against every GEO and REF field, see ADEXP.PointPosition
+func (doc Document) PointPosition(id string, variation float64) (Position, error) {
+	if gp, ok := doc.geoPoint(id); ok {
+		return gp.Position, nil
+	}
+	for _, f := range doc.All("REF") {
+		mul, ok := f.Structured()
+		if !ok {
+			continue
+		}
+		rp, err := mul.GetRefPoint()
+		if err != nil || rp.ID != id {
+			continue
+		}
+		gp, ok := doc.geoPoint(rp.PointID)
+		if !ok {
+			return Position{}, errors.Wrapf(ErrFieldNotFound, "PointPosition: point of reference %s of %s isn't defined", rp.PointID, id)
+		}
+		return rp.From(gp.Position, variation), nil
+	}
+	return Position{}, errors.Wrapf(ErrFieldNotFound, "PointPosition: point %s isn't defined", id)
+}
+
+// geoPoint returns the point with the given ID among the GEO fields of the document
+func (doc Document) geoPoint(id string) (GeoPoint, bool) {
+	for _, f := range doc.All("GEO") {
+		mul, ok := f.Structured()
+		if !ok {
+			continue
+		}
+		if gp, err := mul.GetGeoPoint(); err == nil && gp.ID == id {
+			return gp, true
+		}
+	}
+	return GeoPoint{}, false
+}
@@ -0,0 +1,151 @@
+package adexp
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseLatitude(t *testing.T) {
+	for _, test := range []struct {
+		str string
+		deg float64
+	}{
+		{"520000N", 52},
+		{"523000S", -52.5},
+		{"000036N", 0.01},
+		{"5230N", 52.5},
+		{"52N", 52},
+	} {
+		deg, err := ParseLatitude(test.str)
+		if err != nil || math.Abs(deg-test.deg) > 1e-9 {
+			t.Errorf("%s: expected %f, got %f (%v)", test.str, test.deg, deg, err)
+		}
+	}
+
+	for _, str := range []string{"", "520000", "520000E", "52000N", "526000N", "910000N", "5200A0N"} {
+		if _, err := ParseLatitude(str); err == nil {
+			t.Errorf("%q: expected an error", str)
+		}
+	}
+}
+
+func TestParseLongitude(t *testing.T) {
+	for _, test := range []struct {
+		str string
+		deg float64
+	}{
+		{"0150000W", -15},
+		{"1793000E", 179.5},
+		{"01530E", 15.5},
+		{"015W", -15},
+	} {
+		deg, err := ParseLongitude(test.str)
+		if err != nil || math.Abs(deg-test.deg) > 1e-9 {
+			t.Errorf("%s: expected %f, got %f (%v)", test.str, test.deg, deg, err)
+		}
+	}
+
+	for _, str := range []string{"", "150000W", "0150000N", "1810000E"} {
+		if _, err := ParseLongitude(str); err == nil {
+			t.Errorf("%q: expected an error", str)
+		}
+	}
+}
+
+func TestPosition_String(t *testing.T) {
+	for _, str := range []string{"520000N0150000W", "000001S1795959E", "452512N0063030E"} {
+		p, err := ParsePosition(str)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", str, err)
+			continue
+		}
+		if p.String() != str {
+			t.Errorf("%s: formatted back as %s", str, p)
+		}
+	}
+
+	p, err := ParsePosition("5230N01530W")
+	if err != nil || p != (Position{52.5, -15.5}) {
+		t.Errorf("unexpected short position %v (%v)", p, err)
+	}
+}
+
+func TestPosition_Destination(t *testing.T) {
+	origin := Position{Latitude: 50, Longitude: 0}
+
+	// One degree of latitude is 60 nautical miles
+	north := origin.Destination(0, 60)
+	if math.Abs(north.Latitude-51) > 0.01 || math.Abs(north.Longitude) > 1e-9 {
+		t.Errorf("unexpected destination %v", north)
+	}
+
+	east := origin.Destination(90, 100)
+	if d := origin.DistanceTo(east); math.Abs(d-100) > 1e-6 {
+		t.Errorf("expected a distance of 100NM, got %f", d)
+	}
+}
+
+func TestRefPoint_From(t *testing.T) {
+	origin := Position{Latitude: 50, Longitude: 0}
+	rp := RefPoint{ID: "REF01", PointID: "GEO01", Bearing: 350, Distance: 60}
+
+	// A magnetic bearing of 350 with a variation of 10 degrees east is due north
+	north := rp.From(origin, 10)
+	if math.Abs(north.Latitude-51) > 0.01 || math.Abs(north.Longitude) > 1e-9 {
+		t.Errorf("unexpected position %v", north)
+	}
+	if p := rp.From(origin, 0); p.Longitude >= 0 {
+		t.Errorf("expected a point west of the origin without variation, got %v", p)
+	}
+}
+
+func TestADEXP_PointPosition(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE IFPL -GEO -GEOID GEO01 -LATTD 520000N -LONGTD 0150000W " +
+		"-REF -REFID REF01 -PTID GEO01 -BRNG 180 -DISTNC 60")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	gp, err := msg.GetGeoPoint("GEO")
+	if err != nil || gp.ID != "GEO01" || gp.Position != (Position{52, -15}) {
+		t.Errorf("unexpected GEO point %v (%v)", gp, err)
+	}
+	rp, err := msg.GetRefPoint("REF")
+	if err != nil || rp != (RefPoint{"REF01", "GEO01", 180, 60}) {
+		t.Errorf("unexpected REF point %v (%v)", rp, err)
+	}
+
+	p, err := msg.PointPosition("REF01", 0)
+	if err != nil || math.Abs(p.Latitude-51) > 0.01 || math.Abs(p.Longitude+15) > 1e-9 {
+		t.Errorf("unexpected position of REF01: %v (%v)", p, err)
+	}
+	if _, err := msg.PointPosition("XETBO", 0); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound for a named point, got %v", err)
+	}
+}
+
+func TestDocument_PointPosition(t *testing.T) {
+	var doc Document
+	err := doc.UnmarshalText([]byte("-TITLE IFPL -GEO -GEOID GEO01 -LATTD 520000N -LONGTD 0150000W -GEO -GEOID GEO02 -LATTD 510000N -LONGTD 0140000W " +
+		"-REF -REFID REF01 -PTID GEO01 -BRNG 180 -DISTNC 60 -REF -REFID REF02 -PTID GEO03 -BRNG 90 -DISTNC 10"))
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	for id, expected := range map[string]Position{"GEO01": {52, -15}, "GEO02": {51, -14}} {
+		if p, err := doc.PointPosition(id, 0); err != nil || p != expected {
+			t.Errorf("unexpected position of %s: %v (%v)", id, p, err)
+		}
+	}
+	p, err := doc.PointPosition("REF01", 0)
+	if err != nil || math.Abs(p.Latitude-51) > 0.01 || math.Abs(p.Longitude+15) > 1e-9 {
+		t.Errorf("unexpected position of REF01: %v (%v)", p, err)
+	}
+	if _, err := doc.PointPosition("REF02", 0); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound for an undefined point of reference, got %v", err)
+	}
+}
@@ -1,5 +1,7 @@
 package adexp
 
+import "github.com/pkg/errors"
+
 // GetUnderlying returns the value behind a key.
 //
 // It is preferred to use GetPrimary / GetStructured / GetList instead
@@ -11,6 +13,12 @@ func (msg ADEXP) GetUnderlying(key string) (val interface{}, ok bool) {
 	return v.value, true
 }
 
+// get returns the value associated with a key
+func (msg ADEXP) get(key string) (value, bool) {
+	v, ok := msg[key]
+	return v, ok
+}
+
 // GetKind returns the kind of the value associated with a key
 func (msg ADEXP) GetKind(key string) (kind Kind, ok bool) {
 	v, ok := msg[key]
@@ -24,6 +32,7 @@ func (msg ADEXP) GetKind(key string) (kind Kind, ok bool) {
 // GetPrimary returns the primary field associated with the key.
 //
 // If the key isn't associated with a primary field, either because there is no such key or the value is of a different kind, ok returns as false.
+// ok is false as well for a value inconsistent with its kind, which the typed getters and Lookup report as ErrInconsistentValue.
 func (msg ADEXP) GetPrimary(key string) (val string, ok bool) {
 	v, ok := msg[key]
 	if !ok {
@@ -33,9 +42,9 @@ func (msg ADEXP) GetPrimary(key string) (val string, ok bool) {
 	if v.kind == Primary {
 		pf, ok := v.value.(string)
 		if !ok {
-			panic("wildly unexpected wrong type")
+			return "", false
 		}
-		return string(pf), true
+		return pf, true
 	}
 	return "", false
 }
@@ -43,6 +52,8 @@ func (msg ADEXP) GetPrimary(key string) (val string, ok bool) {
 // GetStructured returns the structured field associated with the key.
 //
 // If the key isn't associated with a structured field, either because there is no such key or the value is of a different kind, ok returns as false.
+// ok is false as well for a value inconsistent with its kind, which the typed getters and Lookup report as ErrInconsistentValue.
+// The field returned is a copy, changes to it are stored back with AddStructured.
 func (msg ADEXP) GetStructured(key string) (val *Multi, ok bool) {
 	v, ok := msg[key]
 	if !ok {
@@ -52,9 +63,9 @@ func (msg ADEXP) GetStructured(key string) (val *Multi, ok bool) {
 	if v.kind == Structured {
 		sf, ok := v.value.(Multi)
 		if !ok {
-			panic("wildly unexpected wrong type")
+			return nil, false
 		}
-		return &sf, true
+		return detached(sf), true
 	}
 	return nil, false
 }
@@ -62,6 +73,8 @@ func (msg ADEXP) GetStructured(key string) (val *Multi, ok bool) {
 // GetList returns the list field associated with the key.
 //
 // If the key isn't associated with a list field, either because there is no such key or the value is of a different kind, ok returns as false.
+// ok is false as well for a value inconsistent with its kind, which the typed getters and Lookup report as ErrInconsistentValue.
+// The field returned is a copy, changes to it are stored back with AddList.
 func (msg ADEXP) GetList(key string) (val *Multi, ok bool) {
 	v, ok := msg[key]
 	if !ok {
@@ -71,9 +84,38 @@ func (msg ADEXP) GetList(key string) (val *Multi, ok bool) {
 	if v.kind == List {
 		lf, ok := v.value.(Multi)
 		if !ok {
-			panic("wildly unexpected wrong type")
+			return nil, false
 		}
-		return &lf, true
+		return detached(lf), true
 	}
 	return nil, false
 }
+
+// Len returns the number of fields of the message
+func (msg ADEXP) Len() int {
+	return len(msg)
+}
+
+// Keys returns the keywords of the message, TITLE first and then sorted
+func (msg ADEXP) Keys() []string {
+	return sortedKeys(msg)
+}
+
+// Range calls f for each field, in the order of Keys, until f returns false
+func (msg ADEXP) Range(f func(field Field) bool) {
+	for _, k := range sortedKeys(msg) {
+		if !f(Field{Keyword: k, value: msg[k]}) {
+			return
+		}
+	}
+}
+
+// Lookup returns the field at the given path, such as "RTEPTS/PT[1]/FL" for the flight level of the second point of the route.
+//
+// The path is made of keywords separated by slashes, each one being a subfield or an element of the field preceding it.
+// Elements of lists are indexed among the elements of the same keyword, starting at 0, as in ValidationError.Path; without an index, the first one is returned.
+// If there is no field at the path, the error is caused by ErrFieldNotFound.
+func (msg ADEXP) Lookup(path string) (Field, error) {
+	f, err := lookup(value{kind: Structured, value: Multi{m: msg, kind: Structured}}, path)
+	return f, errors.Wrap(err, "Lookup")
+}
@@ -0,0 +1,245 @@
+package adexp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/pkg/errors"
+)
+
+// MarshalJSON implements json.Marshaler.
+//
+// The message is mapped to a JSON object, whose members are its fields, TITLE first and then in alphabetical order:
+//
+//	primary fields are strings,
+//	structured fields are objects, whose members are the subfields,
+//	list fields are arrays of objects with a single member, keeping the order of the elements even when keywords repeat.
+//
+// For example, "-TITLE IFPL -ARCID AFR456 -BEGIN ADDR -FAC LFPGZQZX -FAC EGLLZQZX -END ADDR -ESTDATA -PTID XETBO -ETO 140110093000"
+// is mapped to {"TITLE":"IFPL","ADDR":[{"FAC":"LFPGZQZX"},{"FAC":"EGLLZQZX"}],"ARCID":"AFR456","ESTDATA":{"ETO":"140110093000","PTID":"XETBO"}}
+func (msg ADEXP) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := writeJSONObject(buf, map[string]value(msg), nil); err != nil {
+		return nil, errors.Wrap(err, "MarshalJSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, following the mapping of MarshalJSON.
+// The fields are added to the message, which is created if nil.
+func (msg *ADEXP) UnmarshalJSON(data []byte) error {
+	val, err := readJSONData(data)
+	if err != nil {
+		return errors.Wrap(err, "UnmarshalJSON")
+	}
+	if val.kind != Structured {
+		return errors.Errorf("UnmarshalJSON: expected an object, got a %s", val.kind)
+	}
+	if *msg == nil {
+		*msg = ADEXP{}
+	}
+	for k, v := range val.value.(Multi).m {
+		(*msg)[k] = v
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, with the mapping of ADEXP.MarshalJSON: an object for a structured field, an array for a list field
+func (mul Multi) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := writeJSON(buf, value{kind: mul.kind, value: mul}); err != nil {
+		return nil, errors.Wrap(err, "MarshalJSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the mapping of ADEXP.MarshalJSON: an object gives a structured field, an array a list field
+func (mul *Multi) UnmarshalJSON(data []byte) error {
+	val, err := readJSONData(data)
+	if err != nil {
+		return errors.Wrap(err, "UnmarshalJSON")
+	}
+	if val.kind == Primary {
+		return errors.New("UnmarshalJSON: expected an object or an array, got a string")
+	}
+	*mul = val.value.(Multi)
+	return nil
+}
+
+// writeJSON writes the JSON mapping of the value to buf
+func writeJSON(buf *bytes.Buffer, val value) error {
+	switch val.kind {
+	case Primary:
+		str, ok := val.value.(string)
+		if !ok {
+			return errors.Errorf("writeJSON: kind %s but value of type %T", val.kind, val.value)
+		}
+		return writeJSONString(buf, str)
+
+	case Structured:
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return errors.Errorf("writeJSON: kind %s but value of type %T", val.kind, val.value)
+		}
+		return writeJSONObject(buf, mul.m, mul.items)
+
+	case List:
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return errors.Errorf("writeJSON: kind %s but value of type %T", val.kind, val.value)
+		}
+		buf.WriteByte('[')
+		for i, e := range mul.items {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONObject(buf, nil, []entry{e}); err != nil {
+				return errors.Wrapf(err, "writeJSON: list element #%d (%s)", i, e.keyword)
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	default:
+		return errors.Errorf("writeJSON: unknown kind %d", val.kind)
+	}
+}
+
+// writeJSONObject writes the fields as a JSON object, following the order of items if not nil, or else the order of sortedKeys
+func writeJSONObject(buf *bytes.Buffer, m map[string]value, items []entry) error {
+	if items == nil {
+		for _, k := range sortedKeys(m) {
+			items = append(items, entry{keyword: k, value: m[k]})
+		}
+	}
+
+	buf.WriteByte('{')
+	for i, e := range items {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSONString(buf, e.keyword); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		if err := writeJSON(buf, e.value); err != nil {
+			return errors.Wrapf(err, "writeJSONObject: field %s", e.keyword)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeJSONString writes a JSON string
+func writeJSONString(buf *bytes.Buffer, str string) error {
+	b, err := json.Marshal(str)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+// readJSONData reads the only value of data from its JSON mapping
+func readJSONData(data []byte) (value, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	val, err := readJSON(dec)
+	if err != nil {
+		return value{}, err
+	}
+	if tok, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errors.Errorf("unexpected %v", tok)
+		}
+		return value{}, errors.Wrap(err, "readJSONData: data after the value")
+	}
+	return val, nil
+}
+
+// readJSON reads a value from its JSON mapping.
+// The keywords and primary values are checked, so that the result can be encoded in ADEXP.
+func readJSON(dec *json.Decoder) (value, error) {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return value{}, errors.New("readJSON: unexpected end of input")
+	} else if err != nil {
+		return value{}, errors.Wrap(err, "readJSON")
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		mul := Multi{m: make(map[string]value), kind: Structured}
+		for dec.More() {
+			keyword, val, err := readJSONMember(dec)
+			if err != nil {
+				return value{}, err
+			}
+			if _, ok := mul.m[keyword]; ok {
+				return value{}, errors.Errorf("readJSON: duplicate field %s", keyword)
+			}
+			mul.m[keyword] = val
+			mul.items = append(mul.items, entry{keyword: keyword, value: val})
+		}
+		if _, err := dec.Token(); err != nil {
+			return value{}, errors.Wrap(err, "readJSON")
+		}
+		return value{kind: Structured, value: mul}, nil
+
+	case json.Delim('['):
+		mul := Multi{m: make(map[string]value), items: []entry{}, kind: List}
+		for i := 0; dec.More(); i++ {
+			if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+				return value{}, errors.Errorf("readJSON: list element #%d isn't an object", i)
+			}
+			keyword, val, err := readJSONMember(dec)
+			if err != nil {
+				return value{}, errors.Wrapf(err, "readJSON: list element #%d", i)
+			}
+			if tok, err := dec.Token(); err != nil || tok != json.Delim('}') {
+				return value{}, errors.Errorf("readJSON: list element #%d has more than one member", i)
+			}
+			mul.items = append(mul.items, entry{keyword: keyword, value: val})
+
+			// Only the first element with a given keyword is directly accessible
+			if _, ok := mul.m[keyword]; !ok {
+				mul.m[keyword] = val
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return value{}, errors.Wrap(err, "readJSON")
+		}
+		return value{kind: List, value: mul}, nil
+
+	default:
+		str, ok := tok.(string)
+		if !ok {
+			return value{}, errors.Errorf("readJSON: unexpected %v, expected a string, an object or an array", tok)
+		}
+		if err := lexer.CheckValue(str); err != nil {
+			return value{}, errors.Wrap(err, "readJSON")
+		}
+		return value{kind: Primary, value: str}, nil
+	}
+}
+
+// readJSONMember reads a member of an object, whose name is a keyword
+func readJSONMember(dec *json.Decoder) (string, value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", value{}, errors.Wrap(err, "readJSONMember")
+	}
+	keyword, ok := tok.(string)
+	if !ok {
+		return "", value{}, errors.Errorf("readJSONMember: unexpected %v, expected a keyword", tok)
+	}
+	if err := lexer.CheckKeyword(keyword); err != nil {
+		return "", value{}, errors.Wrap(err, "readJSONMember")
+	}
+	val, err := readJSON(dec)
+	if err != nil {
+		return "", value{}, errors.Wrapf(err, "readJSONMember: field %s", keyword)
+	}
+	return keyword, val, nil
+}
@@ -0,0 +1,96 @@
+package adexp
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// testJSON is the JSON mapping of testMsg
+const testJSON = `{"TITLE":"SAM","ADDR":[{"FAC":"LLEVZPZX"},{"FAC":"LFFFZQZX"}],"ADEP":"LFPG","ARCID":"AFR 456","GEO":{"GEOID":"01","LATTD":"520000N","LONGTD":"0150000W"}}`
+
+func TestADEXP_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(testMsg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(b) != testJSON {
+		t.Errorf("unexpected JSON:\n%s\nexpected:\n%s", b, testJSON)
+	}
+}
+
+func TestADEXP_UnmarshalJSON(t *testing.T) {
+	var msg ADEXP
+	if err := json.Unmarshal([]byte(testJSON), &msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := msg.MarshalText()
+	if err != nil {
+		t.Fatalf("error while encoding the result: %v", err)
+	}
+	expected, _ := testMsg.MarshalText()
+	if !bytes.Equal(got, expected) {
+		t.Errorf("unexpected message:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	addr, ok := msg.GetList("ADDR")
+	if !ok || len(addr.items) != 2 {
+		t.Fatalf("unexpected ADDR: %v", addr)
+	}
+	if fac, _ := addr.GetPrimary("FAC"); fac != "LLEVZPZX" {
+		t.Errorf("the first FAC should be directly accessible, got %q", fac)
+	}
+
+	for _, data := range []string{
+		`[]`,
+		`{"TITLE":1}`,
+		`{"title":"SAM"}`,
+		`{"TITLE":"SAM","ARCID":"AFR-456"}`,
+		`{"ADDR":[{"FAC":"LLEVZPZX","RFAC":"LFFFZQZX"}]}`,
+		`{"ADDR":["LLEVZPZX"]}`,
+		`{"ADDR":[{}]}`,
+		`{"GEO":{"GEOID":"01","GEOID":"02"}}`,
+	} {
+		var msg ADEXP
+		if err := json.Unmarshal([]byte(data), &msg); err == nil {
+			t.Errorf("%s: expected an error", data)
+		}
+	}
+
+	// Data after the value is refused, as json.Unmarshal does
+	for _, data := range []string{`{"TITLE":"IFPL"} garbage`, `{"TITLE":"IFPL"}{}`} {
+		var msg ADEXP
+		if err := msg.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", data)
+		}
+		var mul Multi
+		if err := mul.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error from Multi", data)
+		}
+	}
+}
+
+func TestMulti_JSON(t *testing.T) {
+	data := `[{"PT":{"PTID":"XETBO","FL":"F350"}},{"VEC":{"RELDIST":"10"}},{"PT":{"PTID":"BUBLI"}}]`
+	var mul Multi
+	if err := json.Unmarshal([]byte(data), &mul); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mul.kind != List || len(mul.items) != 3 || mul.items[2].keyword != "PT" {
+		t.Fatalf("unexpected list: %#v", mul)
+	}
+
+	// Subfields keep their order
+	b, err := json.Marshal(mul)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(b) != data {
+		t.Errorf("unexpected JSON:\n%s\nexpected:\n%s", b, data)
+	}
+
+	if err := json.Unmarshal([]byte(`"XETBO"`), &mul); err == nil {
+		t.Errorf("expected an error for a primary field")
+	}
+}
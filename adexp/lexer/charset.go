@@ -0,0 +1,100 @@
+package lexer
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// These are the flags of the character classes of the specification, over the IA-5 character set
+const (
+	classAlpha     uint8 = 1 << iota // ALPHA: upper-case letters
+	classDigit                       // DIGIT
+	classLimChar                     // LIM_CHAR: ALPHANUM, space and ( ) ? : . , ' = + /
+	classSeparator                   // Separators between the lexemes: space, tabulations, line feeds and carriage returns
+)
+
+// classes holds the classes of each IA-5 character
+var classes = func() (classes [128]uint8) {
+	for c := 'A'; c <= 'Z'; c++ {
+		classes[c] |= classAlpha | classLimChar
+	}
+	for c := '0'; c <= '9'; c++ {
+		classes[c] |= classDigit | classLimChar
+	}
+	for _, c := range " ()?:.,'=+/" {
+		classes[c] |= classLimChar
+	}
+	for _, c := range " \t\n\v\f\r" {
+		classes[c] |= classSeparator
+	}
+	return classes
+}()
+
+// is returns true if r is an IA-5 character of one of the given classes
+func is(r rune, class uint8) bool {
+	return r >= 0 && r < rune(len(classes)) && classes[r]&class != 0
+}
+
+// IsAlpha returns true if r is an ALPHA character, i.e. an upper-case letter
+func IsAlpha(r rune) bool {
+	return is(r, classAlpha)
+}
+
+// IsDigit returns true if r is a DIGIT
+func IsDigit(r rune) bool {
+	return is(r, classDigit)
+}
+
+// IsAlphanum returns true if r is an ALPHANUM character, which are the characters of the keywords
+func IsAlphanum(r rune) bool {
+	return is(r, classAlpha|classDigit)
+}
+
+// IsLimChar returns true if r is a LIM_CHAR character, which are the characters of the values
+func IsLimChar(r rune) bool {
+	return is(r, classLimChar)
+}
+
+// IsCharacter returns true if r is a CHARACTER, i.e. a LIM_CHAR or a hyphen.
+// The hyphen can't be used in values, as it introduces the fields.
+func IsCharacter(r rune) bool {
+	return r == '-' || IsLimChar(r)
+}
+
+// IsSeparator returns true if r separates lexemes
+func IsSeparator(r rune) bool {
+	return is(r, classSeparator)
+}
+
+// CheckKeyword checks that keyword can be written as the keyword of a field, i.e. that it is made of ALPHANUM characters
+func CheckKeyword(keyword string) error {
+	if keyword == "" {
+		return errors.New("empty keyword")
+	}
+	for _, r := range keyword {
+		if !IsAlphanum(r) {
+			return errors.Errorf("invalid character %q in keyword %q", r, keyword)
+		}
+	}
+	return nil
+}
+
+// CheckValue checks that value can be written as the value of a primary field and read back as-is.
+// It must be made of LIM_CHAR characters and separators, without leading or trailing separators.
+func CheckValue(value string) error {
+	switch {
+	case value == "":
+		return errors.New("empty value")
+	case strings.ContainsRune(value, '-'):
+		return errors.Errorf("value %q contains a hyphen, which would start a new field", value)
+	case strings.TrimFunc(value, IsSeparator) != value:
+		return errors.Errorf("value %q has leading or trailing separators", value)
+	}
+	for _, r := range value {
+		if !IsLimChar(r) && !IsSeparator(r) {
+			return errors.Errorf("invalid character %q in value %q", r, value)
+		}
+	}
+	return nil
+}
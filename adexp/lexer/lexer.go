@@ -1,11 +1,15 @@
 /*
 Package lexer defines a lexer for ADEXP v3.1
 
-You can find one implementation in the ondemand subpackage
+You can find one implementation in the ondemand subpackage, reading runes as it is asked to lex,
+and another in the slicing subpackage, whose lexemes are slices of its input.
 */
 package lexer
 
-import "io"
+import (
+	"fmt"
+	"io"
+)
 
 // A Kind indicates the kind of the lexeme
 type Kind uint8
@@ -36,10 +40,35 @@ const (
 )
 
 // A Lexeme holds a lexeme, i.e an expression tokenised by the lexer.
-// It is composed of a Kind (i.e what is the type of that lexeme) and a Value
+// It is composed of a Kind (i.e what is the type of that lexeme), a Value and its position in the input
 type Lexeme struct {
 	Kind  Kind
 	Value string
+	Pos   Pos // Pos is the position of the first character of the lexeme, which is the hyphen for keywords, BEGIN and END
+}
+
+// A Pos is a position in the input
+type Pos struct {
+	Offset int // Offset is the byte offset from the start of the input, starting at 0
+	Line   int // Line starts at 1
+	Column int // Column is the rune offset from the start of the line, starting at 1
+}
+
+// String returns the position as "line:column"
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// An Error is an error located in the input, returned by lexers and parsers
+type Error struct {
+	Pos     Pos
+	Snippet string // Snippet is the input leading to the error, or the offending lexeme
+	Err     error
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v (near %q)", e.Pos, e.Err, e.Snippet)
 }
 
 // The LexReader interface allows you to read expressions
@@ -9,17 +9,88 @@ import (
 	"github.com/pkg/errors"
 )
 
+// snippetLength is the maximum length of the snippets of the errors, in runes
+const snippetLength = 32
+
 // onDemandLexReader is what allows you to lex, and by streaming !
 type onDemandLexReader struct {
 	mu      sync.Mutex
 	scanner io.RuneScanner
 	state   stateFn
+
+	// Position tracking
+	pos      lexer.Pos // pos is the position of the next rune
+	last     lexer.Pos // last is the position of the last rune read
+	prev     lexer.Pos // prev is the value of pos before the last rune read, restored when unreading
+	line     []rune    // line holds the end of the current line, for the snippets of the errors
+	prevLine int       // prevLine is the length of line before the last rune read
 }
 
 // New returns a new LexReadCloser given a io.RuneScanner.
 // It returns an on-demand LexReadCloser that reads from the input as it is asked to lex.
 func New(input io.RuneScanner) lexer.LexReadCloser {
-	return &onDemandLexReader{scanner: input, state: startState}
+	return &onDemandLexReader{
+		scanner: input,
+		state:   startState,
+		pos:     lexer.Pos{Line: 1, Column: 1},
+		line:    make([]rune, 0, 2*snippetLength),
+	}
+}
+
+// readRune reads the next rune, keeping track of its position
+func (odl *onDemandLexReader) readRune() (rune, error) {
+	r, size, err := odl.scanner.ReadRune()
+	if err != nil {
+		return r, err
+	}
+
+	// Only the end of the line is kept
+	if len(odl.line) == cap(odl.line) {
+		odl.line = odl.line[:copy(odl.line, odl.line[len(odl.line)-snippetLength:])]
+	}
+	odl.prev, odl.prevLine = odl.pos, len(odl.line)
+
+	odl.last = odl.pos
+	odl.pos.Offset += size
+	if r == '\n' {
+		odl.pos.Line++
+		odl.pos.Column = 1
+		odl.line = odl.line[:0]
+	} else {
+		odl.pos.Column++
+		odl.line = append(odl.line, r)
+	}
+	return r, nil
+}
+
+// unreadRune unreads the last rune read
+func (odl *onDemandLexReader) unreadRune() error {
+	if err := odl.scanner.UnreadRune(); err != nil {
+		return err
+	}
+	odl.pos = odl.prev
+	if odl.prevLine <= len(odl.line) {
+		odl.line = odl.line[:odl.prevLine]
+	}
+	return nil
+}
+
+// snippet returns the end of the current line
+func (odl *onDemandLexReader) snippet() string {
+	if len(odl.line) > snippetLength {
+		return string(odl.line[len(odl.line)-snippetLength:])
+	}
+	return string(odl.line)
+}
+
+// errorf returns an error located at the last rune read
+func (odl *onDemandLexReader) errorf(format string, args ...interface{}) error {
+	return &lexer.Error{Pos: odl.last, Snippet: odl.snippet(), Err: errors.Errorf(format, args...)}
+}
+
+// unexpectedEOF returns an io.ErrUnexpectedEOF located at the end of the input
+func (odl *onDemandLexReader) unexpectedEOF() error {
+	return &lexer.Error{Pos: odl.pos, Snippet: odl.snippet(), Err: io.ErrUnexpectedEOF}
 }
 
 // Lex returns the next expression
@@ -31,6 +102,12 @@ func (odl *onDemandLexReader) ReadLex() (*lexer.Lexeme, error) {
 	)
 	odl.mu.Lock()
 	defer odl.mu.Unlock()
+
+	// If there is no state left, we have already reached the end
+	if odl.state == nil {
+		return nil, io.EOF
+	}
+
 	for i := 0; lexeme == nil; i++ {
 		lexeme, odl.state, err = odl.state(odl)
 		if err == io.EOF {
@@ -36,6 +36,51 @@ Loop:
 	}
 }
 
+func TestLexer_Pos(t *testing.T) {
+	lexer := New(strings.NewReader(" -TITLE SAM\n-ARCID  AFR 456\n-BEGIN ADDR\n"))
+	expected := []struct {
+		value        string
+		offset, line int
+		column       int
+	}{
+		{"TITLE", 1, 1, 2}, {"SAM", 8, 1, 9}, {"ARCID", 12, 2, 1}, {"AFR 456", 20, 2, 9}, {"BEGIN", 28, 3, 1}, {"ADDR", 35, 3, 8},
+	}
+	for i, e := range expected {
+		lex, err := lexer.ReadLex()
+		if err != nil {
+			t.Fatalf("lexeme %d: unexpected error: %v", i, err)
+		}
+		if lex.Value != e.value || lex.Pos.Offset != e.offset || lex.Pos.Line != e.line || lex.Pos.Column != e.column {
+			t.Errorf("lexeme %d: got %q at %d (%s), expected %q at %d (%d:%d)", i, lex.Value, lex.Pos.Offset, lex.Pos, e.value, e.offset, e.line, e.column)
+		}
+	}
+}
+
+func TestLexer_Charset(t *testing.T) {
+	lexer := New(strings.NewReader("-TITLE IFPL -CRFL2 F350 -RMK TCAS (X) A/C +1?:.,'= -BEGIN RTEPTS -PT -PTID N48W010 -END RTEPTS"))
+	expected := []string{"TITLE", "IFPL", "CRFL2", "F350", "RMK", "TCAS (X) A/C +1?:.,'=", "BEGIN", "RTEPTS", "PT", "PTID", "N48W010", "END", "RTEPTS"}
+	for i, e := range expected {
+		lex, err := lexer.ReadLex()
+		if err != nil {
+			t.Fatalf("lexeme %d: unexpected error: %v", i, err)
+		}
+		if lex.Value != e {
+			t.Errorf("lexeme %d: got %q, expected %q", i, lex.Value, e)
+		}
+	}
+
+	for _, str := range []string{"-TITLE ifpl", "-TITLE IFPL -RMK A_B", "-TITLE IFPL -RMK É", "-TIT/LE IFPL"} {
+		lexer := New(strings.NewReader(str))
+		var err error
+		for err == nil {
+			_, err = lexer.ReadLex()
+		}
+		if err == io.EOF {
+			t.Errorf("%q: expected an error", str)
+		}
+	}
+}
+
 func BenchmarkLexer_SlowReader(b *testing.B) {
 	gen := func(d time.Duration) func(*testing.B) {
 		return func(b *testing.B) {
@@ -4,7 +4,6 @@ package ondemand
 
 import (
 	"io"
-	"unicode"
 
 	"github.com/aabizri/aero/adexp/lexer"
 
@@ -26,7 +25,7 @@ type stateFn func(*onDemandLexReader) (*lexer.Lexeme, stateFn, error)
 func startState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 	for i := 0; ; i++ {
 		// Get the next byte
-		current, _, err := odl.scanner.ReadRune()
+		current, err := odl.readRune()
 		if err == io.EOF { // EOFs are completely legal before any start of anything. They just mean that we have an empty input.
 			return nil, nil, err
 		} else if err != nil {
@@ -39,10 +38,10 @@ func startState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 			return nil, keywordState, nil
 
 		// If we are still seing separators, we continue on
-		case unicode.IsSpace(current):
+		case lexer.IsSeparator(current):
 
 		default:
-			return nil, nil, errors.Errorf("startState: unexpected character at offset %d: \"%s\"", i, string(current))
+			return nil, nil, odl.errorf("startState: unexpected character %q", current)
 		}
 	}
 }
@@ -53,14 +52,15 @@ func keywordState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 	var (
 		runes     = make([]rune, 0, 9) // we expect a max keyword length, this shaves off time in growing the slice
 		inKeyword bool
+		start     = odl.last // the hyphen
 	)
 Loop:
 	for i := 0; ; i++ {
 		// Get the current byte
-		current, _, err := odl.scanner.ReadRune()
+		current, err := odl.readRune()
 		switch err {
 		case io.EOF:
-			return nil, nil, io.ErrUnexpectedEOF
+			return nil, nil, odl.unexpectedEOF()
 		case nil:
 		default:
 			return nil, nil, errors.Wrapf(err, "keywordState (iteration %d): error while reading next rune", i)
@@ -68,19 +68,19 @@ Loop:
 
 		// Switch
 		switch {
-		// A keyword can only be composed of upper-case characters
-		case unicode.IsUpper(current):
+		// A keyword can only be composed of upper-case letters and digits
+		case lexer.IsAlphanum(current):
 			runes = append(runes, current)
 			inKeyword = true
 
 		// If we've encontered a separator after having first encountered proper text, we break
-		case unicode.IsSpace(current) && inKeyword:
+		case lexer.IsSeparator(current) && inKeyword:
 			break Loop
 
 		// In case we still haven't encontered the first character, we continue on
-		case unicode.IsSpace(current):
+		case lexer.IsSeparator(current):
 		default:
-			return nil, nil, errors.Errorf("keywordState: unexpected character at offset %d: \"%s\"", i, string(current))
+			return nil, nil, odl.errorf("keywordState: unexpected character %q", current)
 		}
 	}
 	kind := lexer.LexemeKeyword
@@ -101,6 +101,7 @@ Loop:
 	lexeme := &lexer.Lexeme{
 		Kind:  kind,
 		Value: str,
+		Pos:   start,
 	}
 
 	return lexeme, nextState, nil
@@ -108,17 +109,17 @@ Loop:
 
 // in postKeywordState we expect either
 // 	a hyphen signaling a new keyword, and as such we're entering a subFieldState
-// 	an alphanumeric text signaling a value, and as such we're entering a basicFieldState
+// 	any other character signaling a value, and as such we're entering a basicFieldState
 func postKeywordState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 	for i := 0; ; i++ {
 		// Get the rune
-		current, _, err := odl.scanner.ReadRune()
+		current, err := odl.readRune()
 		switch err {
 
 		// If we encounter an EOF here, it means a keyword has no associated value or other keywords, which is invalid.
 		// As such, we return io.ErrUnexpectedEOF
 		case io.EOF:
-			return nil, nil, io.ErrUnexpectedEOF
+			return nil, nil, odl.unexpectedEOF()
 
 		case nil:
 		default:
@@ -131,54 +132,60 @@ func postKeywordState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 		case current == hyphen:
 			return nil, keywordState, nil
 
-		// If we get a letter or digit after the separator, then we have a basic field.
+		// We ignore separators
+		case lexer.IsSeparator(current): // We let it run
+
+		// If we get any other character of a value after the separator, then we have a basic field.
 		// So we return a valueState.
-		case unicode.IsUpper(current) || unicode.IsDigit(current):
-			odl.scanner.UnreadRune()
+		case lexer.IsLimChar(current):
+			odl.unreadRune()
 			return nil, valueState, nil
 
-		// We ignore separators
-		case unicode.IsSpace(current): // We let it run
-
 		// If we have an unknown character, its an error !
 		default:
-			return nil, nil, errors.Errorf("postKeywordState: unexpected character at offset %d: \"%s\"", i, string(current))
+			return nil, nil, odl.errorf("postKeywordState: unexpected character %q", current)
 		}
 	}
 }
 
-// in valueState we expect only alphanumeric values, so if we encounter a hyphen, we return a keywordState
+// in valueState we expect only LIM_CHAR characters, so if we encounter a hyphen, we return a keywordState
 func valueState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 	var (
 		runes      = make([]rune, 0, expectedMaxValueLength) // we expect a max value length, this shaves off time in growing the slice
 		lastNonSep int                                       // index of the latest non-separator valid character
+		seen       bool                                      // whether we've encountered a non-separator character
+		nextState  stateFn = keywordState
 	)
+	start := odl.pos // the first character, as it was unread by postKeywordState
 Loop:
 	for i := 0; ; i++ {
 		// Get the rune
-		current, _, err := odl.scanner.ReadRune()
+		current, err := odl.readRune()
 
 		// If we get an EOF in the value, it is absolutely normal except if we encontered no previous non-separator values, so we simply stop the loop and return what we have
+		// The next state is then startState, which will report the EOF
 		switch {
-		case err == io.EOF && lastNonSep != 0:
+		case err == io.EOF && seen:
+			nextState = startState
 			break Loop
 		case err == io.EOF:
-			return nil, nil, io.ErrUnexpectedEOF
+			return nil, nil, odl.unexpectedEOF()
 		case err != nil:
 			return nil, nil, errors.Wrapf(err, "valueState (iteration %d): error while reading next rune", i)
 		}
 
 		// Switch
 		switch {
-		// A value can be composed of upper-case letters and/or digits, as well as separators
+		// A value can be composed of upper-case letters, digits and the other LIM_CHAR characters, such as '/' or '(', as well as separators
 		// We note the position of the last non-separator element so that we remove trailing separators when we enconter a new keyword
-		case unicode.IsUpper(current) || unicode.IsDigit(current):
-			lastNonSep = i
+		case lexer.IsLimChar(current) && !lexer.IsSeparator(current):
 			runes = append(runes, current)
+			lastNonSep = len(runes) - 1
+			seen = true
 
-		// Separators can be valid inside a value when they are surrounded by upper-case letters and/or digits.
+		// Separators can be valid inside a value when they are surrounded by other characters.
 		// Here we append them to the slice but we will slice later to remove the trailing separators.
-		case unicode.IsSpace(current):
+		case lexer.IsSeparator(current):
 			runes = append(runes, current)
 
 		// A value is terminated by either a new keyword or EOF, we checked for EOF previously, here we check for a new keyword, indicated by a hyphen.
@@ -186,7 +193,7 @@ Loop:
 			break Loop
 
 		default:
-			return nil, nil, errors.Errorf("valueState: unexpected character at offset %d: \"%s\"", i, string(current))
+			return nil, nil, odl.errorf("valueState: unexpected character %q", current)
 		}
 	}
 
@@ -195,24 +202,28 @@ Loop:
 	lexeme := &lexer.Lexeme{
 		Kind:  lexer.LexemeValue,
 		Value: str,
+		Pos:   start,
 	}
 
-	return lexeme, keywordState, nil
+	return lexeme, nextState, nil
 }
 
 // in postListBoundState, we expect an alphanumeric value of lexer.LexemeKeyword kind
 // and we return a startField or EOF
 func postListBoundState(odl *onDemandLexReader) (*lexer.Lexeme, stateFn, error) {
 
-	var runes = make([]rune, 0, expectedMaxKeywordLength) // we expect a max keyword length, this shaves off time in growing the slice
+	var (
+		runes = make([]rune, 0, expectedMaxKeywordLength) // we expect a max keyword length, this shaves off time in growing the slice
+		start lexer.Pos
+	)
 
 Loop:
 	for i := 0; ; i++ {
 		// Get the rune
-		current, _, err := odl.scanner.ReadRune()
+		current, err := odl.readRune()
 		switch {
 		case err == io.EOF && len(runes) == 0: // Here, an EOF is illegal if we haven't yet encontered a keyword, we thus return an io.ErrUnexpectedEOF
-			return nil, nil, io.ErrUnexpectedEOF
+			return nil, nil, odl.unexpectedEOF()
 		case err == io.EOF:
 			break Loop
 		case err != nil:
@@ -221,16 +232,19 @@ Loop:
 
 		// Switch
 		switch {
-		// A keyword is only upper-case
-		case unicode.IsUpper(current):
+		// A keyword is only upper-case letters and digits
+		case lexer.IsAlphanum(current):
+			if len(runes) == 0 {
+				start = odl.last
+			}
 			runes = append(runes, current)
 
 		// A keyword is composed of solely one word
-		case unicode.IsSpace(current):
+		case lexer.IsSeparator(current):
 			break Loop
 
 		default:
-			return nil, nil, errors.Errorf("postListBoundState: unexpected character at offset %d: \"%s\"", i, string(current))
+			return nil, nil, odl.errorf("postListBoundState: unexpected character %q", current)
 		}
 	}
 
@@ -238,6 +252,7 @@ Loop:
 	lexeme := &lexer.Lexeme{
 		Kind:  lexer.LexemeKeyword,
 		Value: str,
+		Pos:   start,
 	}
 
 	return lexeme, startState, nil
@@ -0,0 +1,285 @@
+/*
+Package slicing implements a lexer of ADEXP v3.1 whose lexemes are slices of its input.
+
+Unlike the ondemand lexer, it neither decodes runes nor builds the values: the input is scanned byte by byte with lookup tables,
+and the values of the lexemes are slices of the input, so that lexing doesn't allocate for each lexeme.
+
+The input is either held in memory, or read from a bufio.Reader a buffer at a time.
+As the values are strings, only NewString lexes its input without copying it: New copies the byte slice once,
+and NewReader reads into a buffer it reuses, copying it to a string each time it is refilled.
+*/
+package slicing
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/aabizri/aero/adexp/lexer"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	hyphen = '-'
+
+	// snippetLength is the maximum length of the snippets of the errors, in bytes
+	snippetLength = 32
+
+	// readSize is the minimum room made in the buffer of NewReader before reading into it
+	readSize = 4096
+)
+
+// These are the classes of the bytes
+const (
+	classKeyword   uint8 = 1 << iota // Characters of the keywords (ALPHANUM)
+	classValue                       // Characters of the values (LIM_CHAR), but the separators
+	classSeparator                   // Separators
+)
+
+// classes holds the class of each byte, the bytes out of IA-5 having none
+var classes = func() (classes [256]uint8) {
+	for c := 0; c < utf8.RuneSelf; c++ {
+		switch r := rune(c); {
+		case lexer.IsSeparator(r):
+			classes[c] = classSeparator
+		case lexer.IsAlphanum(r):
+			classes[c] = classKeyword | classValue
+		case lexer.IsLimChar(r):
+			classes[c] = classValue
+		}
+	}
+	return classes
+}()
+
+// slicingLexer is a lexer.LexScanCloser slicing its input
+type slicingLexer struct {
+	mu    sync.Mutex
+	state stateFn
+
+	// Input
+	buf  string        // buf holds the input from offset base on
+	base int           // base is the offset of the start of buf
+	src  *bufio.Reader // src is the rest of the input, nil if there is none
+	raw  []byte        // raw is the buffer src is read into, holding the same input as buf
+	mark int           // mark is the offset from which the input is still needed, but for the snippets of the errors
+
+	// Position tracking, the columns counting runes as the ondemand lexer does
+	p         int // p is the offset of the next byte
+	line      int // line is the line of the next byte
+	lineStart int // lineStart is the offset of the start of the current line
+	col       int // col is the number of runes of the current line before the offset colStart, which may have been dropped from buf
+	colStart  int // colStart is the offset up to which the runes of the current line are counted
+
+	// Lexemes are reused, so that the one returned before the latest stays valid for UnreadLex
+	lexemes [2]lexer.Lexeme
+	cur     int           // cur is the index of the next lexeme to be used
+	last    *lexer.Lexeme // last is the latest lexeme returned
+	lastErr error         // lastErr is the latest error returned
+	unread  bool
+}
+
+// New returns a LexScanCloser lexing the given input.
+// The input is copied once, so that later changes to it don't alter the lexemes, the values of the lexemes being slices of the copy.
+// Use NewString to lex a string without copying it.
+func New(input []byte) lexer.LexScanCloser {
+	return NewString(string(input))
+}
+
+// NewString returns a LexScanCloser lexing the given input, the values of the lexemes being slices of it.
+func NewString(input string) lexer.LexScanCloser {
+	return &slicingLexer{
+		state: startState,
+		buf:   input,
+		line:  1,
+	}
+}
+
+// NewReader returns a LexScanCloser lexing the input read from r.
+// The input is read a buffer at a time, the values of the lexemes being slices of a copy of these buffers.
+func NewReader(r *bufio.Reader) lexer.LexScanCloser {
+	return &slicingLexer{
+		state: startState,
+		src:   r,
+		line:  1,
+	}
+}
+
+// peek returns the byte at offset p, reading more of the input if needed.
+// ok is false at the end of the input.
+func (sl *slicingLexer) peek() (c byte, ok bool, err error) {
+	if i := sl.p - sl.base; i < len(sl.buf) {
+		return sl.buf[i], true, nil
+	}
+	if sl.src == nil {
+		return 0, false, nil
+	}
+
+	// We need more of the input.
+	// The part before the mark is dropped, but for what the snippets of the errors may need, and the rest moved to the start of the buffer.
+	keep := sl.mark - snippetLength
+	if keep < sl.lineStart {
+		keep = sl.lineStart
+	}
+	if keep > sl.mark {
+		keep = sl.mark
+	}
+	if keep < sl.base {
+		keep = sl.base
+	}
+	n := copy(sl.raw, sl.raw[keep-sl.base:])
+	if cap(sl.raw)-n < readSize {
+		raw := make([]byte, n, 2*(n+readSize))
+		copy(raw, sl.raw)
+		sl.raw = raw
+	}
+	sl.raw = sl.raw[:n]
+	for {
+		m, err := sl.src.Read(sl.raw[n:cap(sl.raw)])
+		if m > 0 {
+			sl.raw = sl.raw[:n+m]
+			break
+		}
+		if err == io.EOF {
+			sl.src = nil
+			return 0, false, nil
+		} else if err != nil {
+			return 0, false, err
+		}
+	}
+
+	// The columns are counted up to what is dropped
+	if keep > sl.colStart {
+		sl.col += runes(sl.slice(sl.colStart, keep))
+		sl.colStart = keep
+	}
+	sl.buf, sl.base = string(sl.raw), keep
+	return sl.buf[sl.p-sl.base], true, nil
+}
+
+// skip skips the separator c at offset p
+func (sl *slicingLexer) skip(c byte) {
+	sl.p++
+	if c == '\n' {
+		sl.line++
+		sl.lineStart, sl.colStart, sl.col = sl.p, sl.p, 0
+	}
+}
+
+// slice returns the input between the offsets start and end
+func (sl *slicingLexer) slice(start int, end int) string {
+	return sl.buf[start-sl.base : end-sl.base]
+}
+
+// posAt returns the position of the byte at offset p of the current line.
+// The runes are counted from the latest position asked for, the positions asked for being increasing but for the last one.
+func (sl *slicingLexer) posAt(p int) lexer.Pos {
+	if p < sl.colStart {
+		return lexer.Pos{Offset: p, Line: sl.line, Column: sl.col - runes(sl.slice(p, sl.colStart)) + 1}
+	}
+	sl.col += runes(sl.slice(sl.colStart, p))
+	sl.colStart = p
+	return lexer.Pos{Offset: p, Line: sl.line, Column: sl.col + 1}
+}
+
+// runes returns the number of runes starting in str, which may begin or end in the middle of one
+func runes(str string) int {
+	n := 0
+	for i := 0; i < len(str); i++ {
+		if !utf8.RuneStart(str[i]) {
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// snippet returns the end of the current line, up to the offset end
+func (sl *slicingLexer) snippet(end int) string {
+	start := end - snippetLength
+	if start < sl.lineStart {
+		start = sl.lineStart
+	}
+	if start < sl.base {
+		start = sl.base
+	}
+	if end-sl.base > len(sl.buf) {
+		end = sl.base + len(sl.buf)
+	}
+	return sl.slice(start, end)
+}
+
+// unexpected returns an error for the unexpected character at offset p
+func (sl *slicingLexer) unexpected(state string) error {
+	r, size := utf8.DecodeRuneInString(sl.buf[sl.p-sl.base:])
+	return &lexer.Error{Pos: sl.posAt(sl.p), Snippet: sl.snippet(sl.p + size), Err: errors.Errorf("%s: unexpected character %q", state, r)}
+}
+
+// unexpectedEOF returns an io.ErrUnexpectedEOF located at the end of the input
+func (sl *slicingLexer) unexpectedEOF() error {
+	return &lexer.Error{Pos: sl.posAt(sl.p), Snippet: sl.snippet(sl.p), Err: io.ErrUnexpectedEOF}
+}
+
+// ReadLex returns the next lexeme.
+// The lexeme is only valid until the second next call to ReadLex, as its memory is then reused.
+func (sl *slicingLexer) ReadLex() (*lexer.Lexeme, error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if sl.unread {
+		sl.unread = false
+		return sl.last, sl.lastErr
+	}
+
+	lex, err := sl.lex()
+	sl.last, sl.lastErr = lex, err
+	return lex, err
+}
+
+// lex returns the next lexeme, running the states until one is found
+func (sl *slicingLexer) lex() (*lexer.Lexeme, error) {
+	lex := &sl.lexemes[sl.cur]
+	for i := 0; sl.state != nil; i++ {
+		var (
+			found bool
+			err   error
+		)
+		found, sl.state, err = sl.state(sl, lex)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			sl.state = nil
+			return nil, errors.Wrapf(err, "Lex: lexing error in iteration %d", i)
+		}
+		if found {
+			sl.cur ^= 1
+			return lex, nil
+		}
+	}
+	sl.state = nil
+	return nil, io.EOF
+}
+
+// UnreadLex unreads the latest lexeme, only once in a row
+func (sl *slicingLexer) UnreadLex() error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if sl.unread {
+		return errors.New("UnreadLex: cannot unread more than once")
+	}
+	sl.unread = true
+	return nil
+}
+
+// Close closes the lexer, releasing the input
+func (sl *slicingLexer) Close() error {
+	sl.mu.Lock()
+	sl.state = nil
+	sl.buf = ""
+	sl.src = nil
+	sl.raw = nil
+	sl.last = nil
+	sl.mu.Unlock()
+	return nil
+}
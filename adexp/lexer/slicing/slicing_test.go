@@ -0,0 +1,205 @@
+package slicing
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/aabizri/aero/adexp/lexer/buffering"
+	"github.com/aabizri/aero/adexp/lexer/ondemand"
+	"github.com/aabizri/aero/internal/repeating"
+	"github.com/pkg/errors"
+)
+
+const testString = " -TITLE SAM -ARCID AFR 456 -IFPLID XX11111111 -ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0900 -CTOT 0930 -REGUL XXXXXXX -REGCAUSE XXXX -TAXITIME XXXXX -GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W -BEGIN ADDR -FAC LLEVZPZX -FAC LFFFZQZX -END ADDR"
+
+// testText has several lines, separators around the values and the LIM_CHAR characters
+const testText = "-TITLE IFPL\n-ARCID  AFR 456 \n-RMK TCAS (X) A/C +1?:.,'=\n-BEGIN RTEPTS\n  -PT -PTID XETBO -FL F350\n-END RTEPTS\n-CRFL2 F370\n"
+
+// lexAll returns all the lexemes, copied
+func lexAll(t *testing.T, lr lexer.LexReader) []lexer.Lexeme {
+	var lexemes []lexer.Lexeme
+	for {
+		lex, err := lr.ReadLex()
+		if err == io.EOF {
+			return lexemes
+		} else if err != nil {
+			t.Fatalf("lexeme %d: unexpected error: %v", len(lexemes), err)
+		}
+		lexemes = append(lexemes, *lex)
+	}
+}
+
+// TestLexer checks that the lexemes, and their positions, are the ones of the ondemand lexer
+func TestLexer(t *testing.T) {
+	for _, text := range []string{testString, testText} {
+		expected := lexAll(t, ondemand.New(strings.NewReader(text)))
+		lexers := map[string]lexer.LexReader{
+			"NewString": NewString(text),
+			"New":       New([]byte(text)),
+			"NewReader": NewReader(bufio.NewReaderSize(strings.NewReader(text), 16)), // The smallest buffer, to read it by chunks
+		}
+		for name, lr := range lexers {
+			got := lexAll(t, lr)
+			if len(got) != len(expected) {
+				t.Errorf("%s: got %d lexemes, expected %d", name, len(got), len(expected))
+				continue
+			}
+			for i := range got {
+				if got[i] != expected[i] {
+					t.Errorf("%s: lexeme %d: got %v, expected %v", name, i, got[i], expected[i])
+				}
+			}
+		}
+	}
+}
+
+func TestLexer_UnreadLex(t *testing.T) {
+	ls := NewString("-TITLE IFPL -ARCID AFR456")
+	ls.ReadLex()
+	second, _ := ls.ReadLex()
+	if err := ls.UnreadLex(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ls.UnreadLex(); err == nil {
+		t.Errorf("expected an error when unreading twice")
+	}
+	if lex, _ := ls.ReadLex(); lex != second || lex.Value != "IFPL" {
+		t.Errorf("unexpected lexeme after unreading: %v", lex)
+	}
+	if lex, _ := ls.ReadLex(); lex.Value != "ARCID" {
+		t.Errorf("unexpected lexeme: %v", lex)
+	}
+	if second.Value != "IFPL" {
+		t.Errorf("lexeme reused too early: got %v", second)
+	}
+}
+
+func TestLexer_Errors(t *testing.T) {
+	for _, text := range []string{"-TITLE ifpl", "TITLE IFPL", "-TITLE IFPL -RMK É", "-TIT/LE IFPL", "-TITLE", "-TITLE IFPL -BEGIN", "-TITLE IFPL -END ADDR-"} {
+		expected := lexer.Error{}
+		for lr := ondemand.New(strings.NewReader(text)); ; {
+			if _, err := lr.ReadLex(); err != nil {
+				expected = *errors.Cause(err).(*lexer.Error)
+				break
+			}
+		}
+
+		lr := NewReader(bufio.NewReaderSize(strings.NewReader(text), 16))
+		var err error
+		for err == nil {
+			_, err = lr.ReadLex()
+		}
+		le, ok := errors.Cause(err).(*lexer.Error)
+		if !ok {
+			t.Errorf("%q: expected a *lexer.Error, got %v", text, err)
+			continue
+		}
+		if le.Pos != expected.Pos || le.Snippet != expected.Snippet || le.Err.Error() != expected.Err.Error() {
+			t.Errorf("%q: got %v, expected %v", text, le, &expected)
+		}
+	}
+}
+
+// TestLexer_Positions checks that the lexers locate the errors of non-ASCII inputs as the ondemand lexer does, counting columns in runes
+func TestLexer_Positions(t *testing.T) {
+	for _, text := range []string{
+		"-TITLE IFPL\n-RMK ÀÉ",
+		"-TITLE IFPL\n-ARCID AFR456 -ADEP ÉGLL -ADES EGLL",
+		"-TITLE IFPL -RMK " + strings.Repeat("A ", 40) + "ÉÉ",
+		"-TITLE IFPL\n-RMK A\xff",
+	} {
+		var expected *lexer.Error
+		for lr := ondemand.New(strings.NewReader(text)); expected == nil; {
+			if _, err := lr.ReadLex(); err != nil {
+				expected = errors.Cause(err).(*lexer.Error)
+			}
+		}
+
+		lexers := map[string]lexer.LexReader{
+			"NewString": NewString(text),
+			"New":       New([]byte(text)),
+			"NewReader": NewReader(bufio.NewReaderSize(strings.NewReader(text), 16)),
+		}
+		for name, lr := range lexers {
+			var err error
+			for err == nil {
+				_, err = lr.ReadLex()
+			}
+			le, ok := errors.Cause(err).(*lexer.Error)
+			if !ok {
+				t.Errorf("%s %q: expected a *lexer.Error, got %v", name, text, err)
+				continue
+			}
+			if le.Pos != expected.Pos {
+				t.Errorf("%s %q: got an error at %s (offset %d), expected %s (offset %d)", name, text, le.Pos, le.Pos.Offset, expected.Pos, expected.Pos.Offset)
+			}
+		}
+	}
+
+	// The columns count runes, a multi-byte one being counted once
+	sl := NewString("-RMK ÀÉ -X").(*slicingLexer)
+	if pos := sl.posAt(len("-RMK ÀÉ -")); pos.Column != 10 {
+		t.Errorf("got column %d, expected 10", pos.Column)
+	}
+}
+
+func TestLexer_Allocs(t *testing.T) {
+	ls := NewString(strings.Repeat(testString, 100))
+	allocs := testing.AllocsPerRun(1000, func() {
+		if _, err := ls.ReadLex(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("got %f allocations per lexeme, expected none", allocs)
+	}
+}
+
+// BenchmarkLexer compares the lexers on a never-ending input
+func BenchmarkLexer(b *testing.B) {
+	lexers := []struct {
+		name string
+		new  func(*repeating.StringReader) lexer.LexReader
+	}{
+		{"slicing", func(sr *repeating.StringReader) lexer.LexReader { return NewReader(bufio.NewReader(sr)) }},
+		{"ondemand", func(sr *repeating.StringReader) lexer.LexReader { return ondemand.New(sr) }},
+		{"buffering", func(sr *repeating.StringReader) lexer.LexReader { return buffering.New(ondemand.New(sr), 100) }},
+	}
+	for _, l := range lexers {
+		b.Run(l.name, func(b *testing.B) {
+			lr := l.new(repeating.NewStringReader(testString))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				lr.ReadLex()
+			}
+			b.StopTimer()
+			if c, ok := lr.(io.Closer); ok {
+				c.Close()
+			}
+		})
+	}
+}
+
+// BenchmarkLexer_Message compares the lexers on a message held in memory
+func BenchmarkLexer_Message(b *testing.B) {
+	b.Run("slicing", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ls := NewString(testString)
+			for _, err := ls.ReadLex(); err == nil; _, err = ls.ReadLex() {
+			}
+		}
+	})
+	b.Run("ondemand", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			lr := ondemand.New(strings.NewReader(testString))
+			for _, err := lr.ReadLex(); err == nil; _, err = lr.ReadLex() {
+			}
+		}
+	})
+}
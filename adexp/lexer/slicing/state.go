@@ -0,0 +1,165 @@
+package slicing
+
+import (
+	"io"
+
+	"github.com/aabizri/aero/adexp/lexer"
+)
+
+// stateFn represents the state of the lexer as a function filling the lexeme if it finds one, and returning the next state
+type stateFn func(sl *slicingLexer, lex *lexer.Lexeme) (found bool, next stateFn, err error)
+
+// In startState we expect either separators or a hyphen, which introduces a keyword.
+// The end of the input is legal here.
+func startState(sl *slicingLexer, lex *lexer.Lexeme) (bool, stateFn, error) {
+	sl.mark = sl.p
+	for {
+		c, ok, err := sl.peek()
+		switch {
+		case err != nil:
+			return false, nil, err
+		case !ok:
+			return false, nil, io.EOF
+		case c == hyphen:
+			sl.p++
+			return false, keywordState, nil
+		case classes[c]&classSeparator != 0:
+			sl.skip(c)
+		default:
+			return false, nil, sl.unexpected("startState")
+		}
+	}
+}
+
+// In keywordState the hyphen has been read, and we expect a keyword followed by a separator.
+// The keyword is either a field's, or BEGIN or END.
+func keywordState(sl *slicingLexer, lex *lexer.Lexeme) (bool, stateFn, error) {
+	pos := sl.posAt(sl.p - 1) // the hyphen
+
+	// Separators may precede the keyword
+	start, end := -1, -1
+	for end == -1 {
+		c, ok, err := sl.peek()
+		switch {
+		case err != nil:
+			return false, nil, err
+		case !ok:
+			return false, nil, sl.unexpectedEOF()
+		case classes[c]&classKeyword != 0:
+			if start == -1 {
+				start = sl.p
+				sl.mark = start
+			}
+			sl.p++
+		case classes[c]&classSeparator != 0 && start != -1:
+			end = sl.p
+			sl.skip(c)
+		case classes[c]&classSeparator != 0:
+			sl.skip(c)
+		default:
+			return false, nil, sl.unexpected("keywordState")
+		}
+	}
+
+	*lex = lexer.Lexeme{Kind: lexer.LexemeKeyword, Value: sl.slice(start, end), Pos: pos}
+	switch lex.Value {
+	case "BEGIN":
+		lex.Kind = lexer.LexemeBEGIN
+		return true, postListBoundState, nil
+	case "END":
+		lex.Kind = lexer.LexemeEND
+		return true, postListBoundState, nil
+	}
+	return true, postKeywordState, nil
+}
+
+// In postKeywordState we expect either a hyphen introducing a subfield's keyword, or a value
+func postKeywordState(sl *slicingLexer, lex *lexer.Lexeme) (bool, stateFn, error) {
+	for {
+		c, ok, err := sl.peek()
+		switch {
+		case err != nil:
+			return false, nil, err
+		case !ok:
+			return false, nil, sl.unexpectedEOF()
+		case c == hyphen:
+			sl.p++
+			return false, keywordState, nil
+		case classes[c]&classSeparator != 0:
+			sl.skip(c)
+		case classes[c]&classValue != 0:
+			return false, valueState, nil
+		default:
+			return false, nil, sl.unexpected("postKeywordState")
+		}
+	}
+}
+
+// In valueState we expect a value, ended by a hyphen introducing the next keyword or by the end of the input.
+// The trailing separators aren't part of the value.
+func valueState(sl *slicingLexer, lex *lexer.Lexeme) (bool, stateFn, error) {
+	var (
+		start = sl.p
+		end   = sl.p // end is the offset after the last non-separator character
+		pos   = sl.posAt(sl.p)
+		next  stateFn
+	)
+	sl.mark = start
+	for next == nil {
+		c, ok, err := sl.peek()
+		switch {
+		case err != nil:
+			return false, nil, err
+		case !ok:
+			next = startState
+		case c == hyphen:
+			sl.p++
+			next = keywordState
+		case classes[c]&classSeparator != 0:
+			sl.skip(c)
+		case classes[c]&classValue != 0:
+			sl.p++
+			end = sl.p
+		default:
+			return false, nil, sl.unexpected("valueState")
+		}
+	}
+
+	*lex = lexer.Lexeme{Kind: lexer.LexemeValue, Value: sl.slice(start, end), Pos: pos}
+	return true, next, nil
+}
+
+// In postListBoundState we expect the keyword of the list following BEGIN or END, ended by a separator or the end of the input
+func postListBoundState(sl *slicingLexer, lex *lexer.Lexeme) (bool, stateFn, error) {
+	var (
+		start, end = -1, -1
+		pos        lexer.Pos
+	)
+	for end == -1 {
+		c, ok, err := sl.peek()
+		switch {
+		case err != nil:
+			return false, nil, err
+		case !ok && start == -1:
+			return false, nil, sl.unexpectedEOF()
+		case !ok:
+			end = sl.p
+		case classes[c]&classKeyword != 0:
+			if start == -1 {
+				start, pos = sl.p, sl.posAt(sl.p)
+				sl.mark = start
+			}
+			sl.p++
+		case classes[c]&classSeparator != 0 && start != -1:
+			end = sl.p
+			sl.skip(c)
+		case classes[c]&classSeparator != 0:
+			sl.skip(c)
+		default:
+			return false, nil, sl.unexpected("postListBoundState")
+		}
+	}
+
+	*lex = lexer.Lexeme{Kind: lexer.LexemeKeyword, Value: sl.slice(start, end), Pos: pos}
+	return true, startState, nil
+}
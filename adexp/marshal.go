@@ -2,7 +2,22 @@ package adexp
 
 import (
 	"bytes"
+	"encoding"
 	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/aabizri/aero/adexp/parser"
+	"github.com/pkg/errors"
+)
+
+const (
+	beginKeyword = "BEGIN"
+	endKeyword   = "END"
 )
 
 // MarshalText marshals an ADEXP message to string
@@ -13,11 +28,22 @@ func (msg ADEXP) MarshalText() ([]byte, error) {
 	return buf.Bytes(), err
 }
 
+// Marshal returns the ADEXP encoding of the struct v.
+//
+// Struct fields are matched to ADEXP fields via their "adexp" tag, see Encoder.EncodeValue for the details.
+func Marshal(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(buf)
+	err := enc.EncodeValue(v)
+	return buf.Bytes(), err
+}
+
 // An Encoder writes an ADEXP map to an output stream
 // Note that it is much more efficient to use Encoder with a streaming io.Writer.
 type Encoder struct {
 	sep    string
 	indent string
+	order  []string // order are the keywords written first, see SetOrder
 	writer io.Writer
 }
 
@@ -33,18 +59,315 @@ func NewEncoder(w io.Writer) *Encoder {
 // SetIndent sets the indentation. If indent is "", then no indentation will be applied.
 // The default indentation is \t. If you want tabs as a separator then you might use SetSeparator.
 func (enc *Encoder) SetIndent(indent string) error {
+	if !isSeparator(indent) {
+		return errors.Errorf("SetIndent: indentation %q contains non-separator characters", indent)
+	}
 	enc.indent = indent
 	return nil
 }
 
 // SetSep sets the separator. There has to be at least one width of separator.
 func (enc *Encoder) SetSep(sep string) error {
+	if sep == "" {
+		return errors.New("SetSep: separator cannot be empty")
+	} else if !isSeparator(sep) {
+		return errors.Errorf("SetSep: separator %q contains non-separator characters", sep)
+	}
 	enc.sep = sep
 	return nil
 }
 
+// SetOrder sets the keywords of the fields written first by Encode and EncodeValue, in the given order.
+// The other fields follow in their usual order, and the TITLE field is always written first.
+func (enc *Encoder) SetOrder(keywords ...string) {
+	enc.order = keywords
+}
+
+// sortEntries stably sorts the entries of a message: TITLE first, then the keywords given to SetOrder, then the others
+func (enc *Encoder) sortEntries(entries []entry) {
+	rank := make(map[string]int, len(enc.order)+1)
+	for i := len(enc.order) - 1; i >= 0; i-- {
+		rank[enc.order[i]] = i + 1
+	}
+	rank[parser.TITLEKeyword] = 0
+	sort.Stable(byRank{entries, rank, len(enc.order) + 1})
+}
+
+// byRank sorts entries by the rank of their keyword, the keywords without a rank coming last
+type byRank struct {
+	entries []entry
+	rank    map[string]int
+	last    int
+}
+
+func (br byRank) Len() int      { return len(br.entries) }
+func (br byRank) Swap(i, j int) { br.entries[i], br.entries[j] = br.entries[j], br.entries[i] }
+func (br byRank) Less(i, j int) bool {
+	return br.rankOf(br.entries[i].keyword) < br.rankOf(br.entries[j].keyword)
+}
+
+func (br byRank) rankOf(keyword string) int {
+	if r, ok := br.rank[keyword]; ok {
+		return r
+	}
+	return br.last
+}
+
+// isSeparator returns true if the string is only composed of separators
+func isSeparator(str string) bool {
+	for _, r := range str {
+		if !lexer.IsSeparator(r) {
+			return false
+		}
+	}
+	return true
+}
+
 // Encode encodes a given ADEXP message
+//
+// Each field is written on its own line, the TITLE field first and the others sorted by keyword, unless ordered by SetOrder.
+// Subfields and list elements are indented one level deeper than their parent.
+// Nothing is written if the message cannot be encoded.
 func (enc *Encoder) Encode(msg ADEXP) error {
-	// DO SOMETHING
+	entries := make([]entry, 0, len(msg))
+	for _, keyword := range sortedKeys(msg) {
+		entries = append(entries, entry{keyword: keyword, value: msg[keyword]})
+	}
+	enc.sortEntries(entries)
+
+	buf := &bytes.Buffer{}
+	for _, e := range entries {
+		err := enc.encodeField(buf, e.keyword, e.value, 0)
+		if err != nil {
+			return errors.Wrapf(err, "Encode: error while encoding field %s", e.keyword)
+		}
+	}
+
+	_, err := buf.WriteTo(enc.writer)
+	return err
+}
+
+// EncodeValue encodes the struct v, or pointer to a struct, as an ADEXP message.
+//
+// Each exported field is associated with the keyword given by its "adexp" tag, or its upper-cased name if there is none.
+// A tag of "-" ignores the field, and the "omitempty" option ignores it when it has its zero value. Nil pointers are always ignored.
+// The fields are written in their declaration order, unless ordered by SetOrder, and the TITLE field is always written first.
+//
+// Strings, integers, floats and encoding.TextMarshaler implementations are encoded as primary fields.
+// A time.Time is encoded in UTC as a datetime (YYMMDDHHMM) unless one of the options "date", "time" or "seconds" is given.
+// Structs are encoded as structured fields.
+// Slices are encoded as list fields, and the keyword of their elements must be given, as in `adexp:"ADDR>FAC"`.
+func (enc *Encoder) EncodeValue(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errors.Errorf("EncodeValue: expected a struct or a non-nil pointer to a struct, got %T", v)
+	}
+
+	entries, err := entriesFromStruct(rv)
+	if err != nil {
+		return errors.Wrap(err, "EncodeValue")
+	}
+
+	enc.sortEntries(entries)
+
+	buf := &bytes.Buffer{}
+	for _, e := range entries {
+		err := enc.encodeField(buf, e.keyword, e.value, 0)
+		if err != nil {
+			return errors.Wrapf(err, "EncodeValue: error while encoding field %s", e.keyword)
+		}
+	}
+
+	_, err = buf.WriteTo(enc.writer)
+	return err
+}
+
+// entriesFromStruct returns the fields of the struct rv, in order
+func entriesFromStruct(rv reflect.Value) ([]entry, error) {
+	fields := structFields(rv.Type())
+	entries := make([]entry, 0, len(fields))
+	for _, fi := range fields {
+		fv := rv.Field(fi.index)
+		if fi.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		val, ok, err := valueFromReflect(fv, fi.element, fi.timeLayout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s (%s)", fi.name, fi.keyword)
+		} else if !ok {
+			continue
+		}
+		entries = append(entries, entry{keyword: fi.keyword, value: val})
+	}
+	return entries, nil
+}
+
+// valueFromReflect returns the value corresponding to rv, ok is false if there is nothing to encode.
+// element is the keyword of the elements if rv is a slice, and layout the format of a time.Time.
+func valueFromReflect(rv reflect.Value, element string, layout string) (val value, ok bool, err error) {
+	// Nil pointers are ignored
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return value{}, false, nil
+		}
+		rv = rv.Elem()
+	}
+
+	// Times and custom marshalers take precedence, time.Time being a TextMarshaler of RFC 3339
+	if rv.Type() == timeType {
+		if layout == "" {
+			layout = datetimeLayout
+		}
+		t := rv.Interface().(time.Time)
+		return value{kind: Primary, value: t.UTC().Format(layout)}, true, nil
+	}
+	tm, ok := rv.Interface().(encoding.TextMarshaler)
+	if !ok && rv.CanAddr() {
+		tm, ok = rv.Addr().Interface().(encoding.TextMarshaler)
+	}
+	if ok {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return value{}, false, err
+		}
+		return value{kind: Primary, value: string(text)}, true, nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		val = value{kind: Primary, value: rv.String()}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val = value{kind: Primary, value: strconv.FormatInt(rv.Int(), 10)}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val = value{kind: Primary, value: strconv.FormatUint(rv.Uint(), 10)}
+
+	case reflect.Float32, reflect.Float64:
+		val = value{kind: Primary, value: strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits())}
+
+	case reflect.Struct:
+		entries, err := entriesFromStruct(rv)
+		if err != nil {
+			return value{}, false, err
+		}
+		mul := Multi{
+			m:     make(map[string]value, len(entries)),
+			items: entries,
+			kind:  Structured,
+		}
+		for _, e := range entries {
+			mul.m[e.keyword] = e.value
+		}
+		val = value{kind: Structured, value: mul}
+
+	case reflect.Slice:
+		if element == "" {
+			return value{}, false, errors.Errorf("the keyword of the list elements is unknown, use a tag such as `adexp:\"LIST>ELEMENT\"`")
+		}
+		mul := Multi{
+			m:     make(map[string]value, 1),
+			items: make([]entry, 0, rv.Len()),
+			kind:  List,
+		}
+		for i := 0; i < rv.Len(); i++ {
+			ev, ok, err := valueFromReflect(rv.Index(i), "", layout)
+			if err != nil {
+				return value{}, false, errors.Wrapf(err, "list element #%d", i)
+			} else if !ok {
+				continue
+			}
+			mul.items = append(mul.items, entry{keyword: element, value: ev})
+			if _, ok := mul.m[element]; !ok {
+				mul.m[element] = ev
+			}
+		}
+		val = value{kind: List, value: mul}
+
+	default:
+		return value{}, false, errors.Errorf("unsupported type %s", rv.Type())
+	}
+
+	return val, true, nil
+}
+
+// encodeField writes the given field to buf, with the given depth of indentation
+func (enc *Encoder) encodeField(buf *bytes.Buffer, keyword string, val value, depth int) error {
+	if err := lexer.CheckKeyword(keyword); err != nil {
+		return err
+	}
+	prefix := strings.Repeat(enc.indent, depth)
+
+	switch val.kind {
+	case Primary:
+		str, ok := val.value.(string)
+		if !ok {
+			return errors.Errorf("encodeField: kind %s but value of type %T", parser.Primary, val.value)
+		}
+		if err := lexer.CheckValue(str); err != nil {
+			return err
+		}
+		buf.WriteString(prefix + "-" + keyword + enc.sep + str + "\n")
+
+	case Structured:
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return errors.Errorf("encodeField: kind %s but value of type %T", parser.Structured, val.value)
+		}
+		buf.WriteString(prefix + "-" + keyword + "\n")
+
+		// If we know the order of the subfields, we follow it
+		if mul.items != nil {
+			for _, e := range mul.items {
+				err := enc.encodeField(buf, e.keyword, e.value, depth+1)
+				if err != nil {
+					return errors.Wrapf(err, "encodeField: error while encoding subfield %s", e.keyword)
+				}
+			}
+			break
+		}
+		for _, k := range sortedKeys(mul.m) {
+			err := enc.encodeField(buf, k, mul.m[k], depth+1)
+			if err != nil {
+				return errors.Wrapf(err, "encodeField: error while encoding subfield %s", k)
+			}
+		}
+
+	case List:
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return errors.Errorf("encodeField: kind %s but value of type %T", parser.List, val.value)
+		}
+		buf.WriteString(prefix + "-" + beginKeyword + enc.sep + keyword + "\n")
+		for i, e := range mul.items {
+			err := enc.encodeField(buf, e.keyword, e.value, depth+1)
+			if err != nil {
+				return errors.Wrapf(err, "encodeField: error while encoding list element #%d (%s)", i, e.keyword)
+			}
+		}
+		buf.WriteString(prefix + "-" + endKeyword + enc.sep + keyword + "\n")
+
+	default:
+		return errors.Errorf("encodeField: unknown kind %d", val.kind)
+	}
+
 	return nil
 }
+
+// sortedKeys returns the keywords of the map, TITLE first and then in alphabetical order
+func sortedKeys(m map[string]value) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if k != parser.TITLEKeyword {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	if _, ok := m[parser.TITLEKeyword]; ok {
+		keys = append([]string{parser.TITLEKeyword}, keys...)
+	}
+	return keys
+}
@@ -0,0 +1,213 @@
+package adexp
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testMsg is the message encoded in testText
+var testMsg = ADEXP{
+	"TITLE": {kind: Primary, value: "SAM"},
+	"ARCID": {kind: Primary, value: "AFR 456"},
+	"ADEP":  {kind: Primary, value: "LFPG"},
+	"GEO": {kind: Structured, value: Multi{
+		kind: Structured,
+		m: map[string]value{
+			"GEOID":  {kind: Primary, value: "01"},
+			"LATTD":  {kind: Primary, value: "520000N"},
+			"LONGTD": {kind: Primary, value: "0150000W"},
+		},
+		items: []entry{
+			{keyword: "GEOID", value: value{kind: Primary, value: "01"}},
+			{keyword: "LATTD", value: value{kind: Primary, value: "520000N"}},
+			{keyword: "LONGTD", value: value{kind: Primary, value: "0150000W"}},
+		},
+	}},
+	"ADDR": {kind: List, value: Multi{
+		kind: List,
+		m: map[string]value{
+			"FAC": {kind: Primary, value: "LLEVZPZX"},
+		},
+		items: []entry{
+			{keyword: "FAC", value: value{kind: Primary, value: "LLEVZPZX"}},
+			{keyword: "FAC", value: value{kind: Primary, value: "LFFFZQZX"}},
+		},
+	}},
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(buf)
+	if err := enc.SetIndent("  "); err != nil {
+		t.Fatalf("error while setting indent: %v", err)
+	}
+	if err := enc.Encode(testMsg); err != nil {
+		t.Fatalf("error while encoding: %v", err)
+	}
+
+	want := "-TITLE SAM\n" +
+		"-BEGIN ADDR\n" +
+		"  -FAC LLEVZPZX\n" +
+		"  -FAC LFFFZQZX\n" +
+		"-END ADDR\n" +
+		"-ADEP LFPG\n" +
+		"-ARCID AFR 456\n" +
+		"-GEO\n" +
+		"  -GEOID 01\n" +
+		"  -LATTD 520000N\n" +
+		"  -LONGTD 0150000W\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected output:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEncoder_SetSep(t *testing.T) {
+	enc := NewEncoder(&bytes.Buffer{})
+	for _, sep := range []string{"", "-", " a "} {
+		if err := enc.SetSep(sep); err == nil {
+			t.Errorf("expected an error for separator %q", sep)
+		}
+	}
+	if err := enc.SetSep("\t"); err != nil {
+		t.Errorf("unexpected error for a tab separator: %v", err)
+	}
+}
+
+func TestEncoder_Encode_InvalidValue(t *testing.T) {
+	msg := ADEXP{
+		"TITLE": {kind: Primary, value: "SAM"},
+		"RMK":   {kind: Primary, value: "NOT-ALLOWED"},
+	}
+	buf := &bytes.Buffer{}
+	if err := NewEncoder(buf).Encode(msg); err == nil {
+		t.Errorf("expected an error for a value containing a hyphen")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %q", buf.String())
+	}
+}
+
+func TestEncoder_RoundTrip(t *testing.T) {
+	text, err := testMsg.MarshalText()
+	if err != nil {
+		t.Fatalf("error while marshalling: %v", err)
+	}
+
+	msg := ADEXP{}
+	if err := msg.UnmarshalText(text); err != nil {
+		t.Fatalf("error while unmarshalling:\n%s\n%v", text, err)
+	}
+	if !reflect.DeepEqual(msg, testMsg) {
+		t.Errorf("round trip mismatch:\ngot:  %v\nwant: %v", msg, testMsg)
+	}
+}
+
+// ackMessage is a message built from domain types
+type ackMessage struct {
+	ARCID  string    `adexp:"ARCID"`
+	Title  string    `adexp:"TITLE"`
+	EOBD   time.Time `adexp:",date"`
+	EOBT   time.Time `adexp:",time"`
+	IFPLID string    `adexp:",omitempty"`
+	Seats  *int
+	MsgRef struct {
+		Sender string `adexp:"SENDER"`
+		Seqnum int    `adexp:"SEQNUM"`
+	} `adexp:"REFDATA"`
+	Addresses []string `adexp:"ADDR>FAC"`
+	Internal  string   `adexp:"-"`
+}
+
+func TestMarshal(t *testing.T) {
+	msg := ackMessage{
+		ARCID:     "AFR456",
+		Title:     "ACK",
+		EOBD:      time.Date(2014, 1, 10, 0, 0, 0, 0, time.UTC),
+		EOBT:      time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC),
+		Addresses: []string{"LLEVZPZX", "LFFFZQZX"},
+		Internal:  "not written",
+	}
+	msg.MsgRef.Sender = "LFPG"
+	msg.MsgRef.Seqnum = 12
+
+	text, err := Marshal(&msg)
+	if err != nil {
+		t.Fatalf("error while marshalling: %v", err)
+	}
+
+	want := "-TITLE ACK\n" +
+		"-ARCID AFR456\n" +
+		"-EOBD 140110\n" +
+		"-EOBT 0900\n" +
+		"-REFDATA\n" +
+		"\t-SENDER LFPG\n" +
+		"\t-SEQNUM 12\n" +
+		"-BEGIN ADDR\n" +
+		"\t-FAC LLEVZPZX\n" +
+		"\t-FAC LFFFZQZX\n" +
+		"-END ADDR\n"
+	if string(text) != want {
+		t.Errorf("unexpected output:\ngot:\n%s\nwant:\n%s", text, want)
+	}
+
+	// It should read back to the same message
+	var got ackMessage
+	if err := Unmarshal(text, &got); err != nil {
+		t.Fatalf("error while unmarshalling:\n%s\n%v", text, err)
+	}
+	msg.Internal = ""
+	if !reflect.DeepEqual(got, msg) {
+		t.Errorf("round trip mismatch:\ngot:  %+v\nwant: %+v", got, msg)
+	}
+}
+
+func TestEncoder_SetOrder(t *testing.T) {
+	msg := struct {
+		ARCID string
+		ADEP  string
+		ADES  string
+		Title string `adexp:"TITLE"`
+	}{"AFR456", "LFPG", "EGLL", "IFPL"}
+
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(buf)
+	enc.SetOrder("ADES", "TITLE", "ADEP")
+	if err := enc.EncodeValue(msg); err != nil {
+		t.Fatalf("error while encoding: %v", err)
+	}
+	want := "-TITLE IFPL\n-ADES EGLL\n-ADEP LFPG\n-ARCID AFR456\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected output of EncodeValue:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Encode follows it too, the other fields being sorted
+	buf.Reset()
+	adexp := ADEXP{
+		"TITLE": {kind: Primary, value: "IFPL"},
+		"ARCID": {kind: Primary, value: "AFR456"},
+		"ADEP":  {kind: Primary, value: "LFPG"},
+		"ADES":  {kind: Primary, value: "EGLL"},
+		"EOBT":  {kind: Primary, value: "0900"},
+	}
+	if err := enc.Encode(adexp); err != nil {
+		t.Fatalf("error while encoding: %v", err)
+	}
+	want = "-TITLE IFPL\n-ADES EGLL\n-ADEP LFPG\n-ARCID AFR456\n-EOBT 0900\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected output of Encode:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMarshal_Errors(t *testing.T) {
+	if _, err := Marshal("-TITLE ACK"); err == nil {
+		t.Errorf("expected an error when marshalling a non-struct")
+	}
+	if _, err := Marshal(struct{ Addr []string }{[]string{"LLEVZPZX"}}); err == nil {
+		t.Errorf("expected an error when marshalling a slice without element keyword")
+	}
+	if _, err := Marshal(struct{ ARCID string }{}); err == nil {
+		t.Errorf("expected an error when marshalling an empty value without omitempty")
+	}
+}
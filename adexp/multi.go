@@ -1,16 +1,32 @@
 package adexp
 
-import "github.com/aabizri/aero/adexp/parser"
+import "github.com/pkg/errors"
 
 // Multi is the structure behind structured & list fields
 type Multi struct {
-	m    map[string]value
-	kind Kind
+	m     map[string]value
+	items []entry // the ordered elements of a list field, or of a structured field when its order is known
+	kind  Kind
+
+	// shared is true if m & items are shared with a stored field, in which case they are copied before being modified
+	shared bool
+}
+
+// entry is a keyword and its value, used to keep the elements of a list in order
+type entry struct {
+	keyword string
+	value
+}
+
+// detached returns a copy of a stored field to be handed out, which can be modified without altering the stored field
+func detached(mul Multi) *Multi {
+	mul.shared = true
+	return &mul
 }
 
 // GetUnderlying returns the value behind a key.
 //
-// It is preferred to use GetPrimary / GetStructured instead
+// It is preferred to use GetPrimary / GetStructured / GetList instead
 func (mul *Multi) GetUnderlying(key string) (val interface{}, ok bool) {
 	v, ok := mul.m[key]
 	if !ok {
@@ -19,6 +35,12 @@ func (mul *Multi) GetUnderlying(key string) (val interface{}, ok bool) {
 	return v.value, true
 }
 
+// get returns the value associated with a key
+func (mul *Multi) get(key string) (value, bool) {
+	v, ok := mul.m[key]
+	return v, ok
+}
+
 // GetKind returns the kind of the value associated with a key
 func (mul *Multi) GetKind(key string) (kind Kind, ok bool) {
 	v, ok := mul.m[key]
@@ -32,6 +54,7 @@ func (mul *Multi) GetKind(key string) (kind Kind, ok bool) {
 // GetPrimary returns the primary field associated with the key.
 //
 // If the key isn't associated with a primary field, either because there is no such key or the value is of a different kind, ok returns as false.
+// ok is false as well for a value inconsistent with its kind, which the typed getters and Lookup report as ErrInconsistentValue.
 func (mul *Multi) GetPrimary(key string) (val string, ok bool) {
 	v, ok := mul.m[key]
 	if !ok {
@@ -39,11 +62,11 @@ func (mul *Multi) GetPrimary(key string) (val string, ok bool) {
 	}
 
 	if v.kind == Primary {
-		pf, ok := v.value.(parser.PrimaryField)
+		pf, ok := v.value.(string)
 		if !ok {
-			panic("wildly unexpected wrong type")
+			return "", false
 		}
-		return string(pf), true
+		return pf, true
 	}
 	return "", false
 }
@@ -51,23 +74,106 @@ func (mul *Multi) GetPrimary(key string) (val string, ok bool) {
 // GetStructured returns the structured field associated with the key.
 //
 // If the key isn't associated with a structured field, either because there is no such key or the value is of a different kind, ok returns as false.
+// ok is false as well for a value inconsistent with its kind, which the typed getters and Lookup report as ErrInconsistentValue.
+// The field returned is a copy, changes to it are stored back with AddStructured.
 func (mul *Multi) GetStructured(key string) (val *Multi, ok bool) {
 	v, ok := mul.m[key]
 	if !ok {
 		return nil, false
 	}
 
-	// If the kind of the Multi is a structured key, and as there are no embedded structured in structured, we return nil, false
-	if mul.kind == Structured {
+	if v.kind == Structured {
+		sf, ok := v.value.(Multi)
+		if !ok {
+			return nil, false
+		}
+		return detached(sf), true
+	}
+	return nil, false
+}
+
+// GetList returns the list field associated with the key.
+//
+// If the key isn't associated with a list field, either because there is no such key or the value is of a different kind, ok returns as false.
+// ok is false as well for a value inconsistent with its kind, which the typed getters and Lookup report as ErrInconsistentValue.
+// The field returned is a copy, changes to it are stored back with AddList.
+func (mul *Multi) GetList(key string) (val *Multi, ok bool) {
+	v, ok := mul.m[key]
+	if !ok {
 		return nil, false
 	}
 
-	if v.kind == Structured {
-		sf, ok := v.value.(Multi)
+	if v.kind == List {
+		lf, ok := v.value.(Multi)
 		if !ok {
-			panic("wildly unexpected wrong type")
+			return nil, false
 		}
-		return &sf, true
+		return detached(lf), true
 	}
 	return nil, false
 }
+
+// Kind returns the kind of the field, Structured or List
+func (mul *Multi) Kind() Kind {
+	return mul.kind
+}
+
+// Len returns the number of subfields of a structured field, or of elements of a list field
+func (mul *Multi) Len() int {
+	if mul.items != nil {
+		return len(mul.items)
+	}
+	return len(mul.m)
+}
+
+// Keys returns the keywords of the subfields of a structured field, in order if known or else sorted,
+// or the keyword of each element of a list field, in order.
+func (mul *Multi) Keys() []string {
+	if mul.items == nil {
+		return sortedKeys(mul.m)
+	}
+	keys := make([]string, len(mul.items))
+	for i, e := range mul.items {
+		keys[i] = e.keyword
+	}
+	return keys
+}
+
+// Range calls f for each subfield or element, in the order of Keys, until f returns false
+func (mul *Multi) Range(f func(field Field) bool) {
+	if mul.items == nil {
+		for _, k := range sortedKeys(mul.m) {
+			if !f(Field{Keyword: k, value: mul.m[k]}) {
+				return
+			}
+		}
+		return
+	}
+	for _, e := range mul.items {
+		if !f(Field{Keyword: e.keyword, value: e.value}) {
+			return
+		}
+	}
+}
+
+// Index returns the subfield or element #i, in the order of Keys
+func (mul *Multi) Index(i int) (Field, error) {
+	if i < 0 || i >= mul.Len() {
+		return Field{}, errors.Errorf("Index: index %d out of range [0, %d)", i, mul.Len())
+	}
+	if mul.items == nil {
+		k := sortedKeys(mul.m)[i]
+		return Field{Keyword: k, value: mul.m[k]}, nil
+	}
+	e := mul.items[i]
+	return Field{Keyword: e.keyword, value: e.value}, nil
+}
+
+// Lookup returns the field at the given path from the Multi, see ADEXP.Lookup
+func (mul *Multi) Lookup(path string) (Field, error) {
+	if mul == nil {
+		return Field{}, errors.Wrapf(ErrFieldNotFound, "Lookup: no field %s in a nil Multi", path)
+	}
+	f, err := lookup(value{kind: mul.kind, value: *mul}, path)
+	return f, errors.Wrap(err, "Lookup")
+}
@@ -0,0 +1,134 @@
+package adexp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testNavigation = "-TITLE IFPL -ARCID AFR456 -GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W " +
+	"-BEGIN RTEPTS -PT -PTID XETBO -FL F350 -ETO 140110093000 -AD LFPG -PT -PTID BUBLI -FL F370 -ETO 140110094000 -END RTEPTS"
+
+// decodeNavigation decodes testNavigation
+func decodeNavigation(t *testing.T) ADEXP {
+	msg := ADEXP{}
+	if err := NewDecoder(strings.NewReader(testNavigation)).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	return msg
+}
+
+func TestADEXP_Range(t *testing.T) {
+	msg := decodeNavigation(t)
+	if msg.Len() != 4 {
+		t.Errorf("expected 4 fields, got %d", msg.Len())
+	}
+	if keys := strings.Join(msg.Keys(), " "); keys != "TITLE ARCID GEO RTEPTS" {
+		t.Errorf("unexpected keys %s", keys)
+	}
+
+	var kinds []string
+	msg.Range(func(f Field) bool {
+		kinds = append(kinds, f.Kind().String())
+		return f.Keyword != "GEO"
+	})
+	if len(kinds) != 3 || kinds[2] != Structured.String() {
+		t.Errorf("unexpected kinds %v", kinds)
+	}
+}
+
+func TestMulti_Index(t *testing.T) {
+	msg := decodeNavigation(t)
+	rtepts, ok := msg.GetList("RTEPTS")
+	if !ok {
+		t.Fatalf("RTEPTS not found")
+	}
+	if rtepts.Len() != 3 || strings.Join(rtepts.Keys(), " ") != "PT AD PT" {
+		t.Errorf("unexpected elements %v", rtepts.Keys())
+	}
+	f, err := rtepts.Index(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pt, ok := f.Structured()
+	if !ok {
+		t.Fatalf("element #2 isn't structured")
+	}
+	if id, _ := pt.GetPrimary("PTID"); id != "BUBLI" {
+		t.Errorf("unexpected PTID %q", id)
+	}
+	if strings.Join(pt.Keys(), " ") != "PTID FL ETO" {
+		t.Errorf("unexpected subfields %v", pt.Keys())
+	}
+	if _, err := rtepts.Index(3); err == nil {
+		t.Errorf("expected an error for an index out of range")
+	}
+
+	var count int
+	rtepts.Range(func(f Field) bool {
+		count++
+		return true
+	})
+	if count != 3 {
+		t.Errorf("expected 3 elements, got %d", count)
+	}
+
+	// A list within a structured field
+	sub := NewStructured()
+	sub.AppendListItem("ADDR", "FAC", "LFPGZQZX")
+	if addr, ok := sub.GetList("ADDR"); !ok || addr.Len() != 1 {
+		t.Errorf("unexpected ADDR %v", addr)
+	}
+	if _, ok := sub.GetList("GEO"); ok {
+		t.Errorf("GEO found")
+	}
+}
+
+func TestADEXP_Lookup(t *testing.T) {
+	msg := decodeNavigation(t)
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"ARCID", "AFR456"},
+		{"GEO/LATTD", "520000N"},
+		{"RTEPTS/PT/PTID", "XETBO"},
+		{"RTEPTS/PT[1]/FL", "F370"},
+		{"RTEPTS/AD", "LFPG"},
+		{"RTEPTS/AD[0]", "LFPG"},
+	}
+	for _, test := range tests {
+		f, err := msg.Lookup(test.path)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.path, err)
+			continue
+		}
+		if str, ok := f.Primary(); !ok || str != test.expected {
+			t.Errorf("%s: got (%q, %t), expected %q", test.path, str, ok, test.expected)
+		}
+	}
+
+	// Lookups from a Multi
+	rtepts, _ := msg.GetList("RTEPTS")
+	if f, err := rtepts.Lookup("PT[1]"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if f.Kind() != Structured {
+		t.Errorf("PT[1] is a %s", f.Kind())
+	}
+	var none *Multi
+	if _, err := none.Lookup("PT[1]"); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound from a nil Multi, got %v", err)
+	}
+
+	for _, path := range []string{"ADEP", "RTEPTS/PT[2]", "GEO/GEOID/X", "RTEPTS/PT[1]/ETO/X"} {
+		if _, err := msg.Lookup(path); errors.Cause(err) != ErrFieldNotFound {
+			t.Errorf("%s: expected ErrFieldNotFound, got %v", path, err)
+		}
+	}
+	for _, path := range []string{"", "GEO[0]/LATTD", "RTEPTS/PT[", "RTEPTS/PT[-1]", "RTEPTS/PT[A]", "rtepts"} {
+		if _, err := msg.Lookup(path); err == nil || errors.Cause(err) == ErrFieldNotFound {
+			t.Errorf("%q: expected a path error, got %v", path, err)
+		}
+	}
+}
@@ -14,6 +14,7 @@ type onDemandParser struct {
 	mu    sync.Mutex
 	lexer lexer.LexScanner
 	state stateFn
+	last  *lexer.Lexeme // last is the last lexeme read, locating an unexpected end of input
 }
 
 // New creates a new on-demand parser.Parser
@@ -32,12 +33,18 @@ func (odp *onDemandParser) Parse() (*parser.Expression, error) {
 	)
 	odp.mu.Lock()
 	defer odp.mu.Unlock()
+
+	// If there is no state left, we have already reached the end
+	if odp.state == nil {
+		return nil, io.EOF
+	}
+
 	for i := 0; expr == nil; i++ {
 		expr, odp.state, err = odp.state(odp)
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return nil, errors.Wrapf(err, "Parse (pass %#d): error while parsing next expression", i)
+			return nil, errors.Wrapf(err, "Parse (pass #%d): error while parsing next expression", i)
 		}
 
 		// If we have no state left, we return
@@ -48,6 +55,23 @@ func (odp *onDemandParser) Parse() (*parser.Expression, error) {
 	return expr, err
 }
 
+// readLex reads the next lexeme, keeping track of the last one
+func (odp *onDemandParser) readLex() (*lexer.Lexeme, error) {
+	lex, err := odp.lexer.ReadLex()
+	if lex != nil {
+		odp.last = lex
+	}
+	return lex, err
+}
+
+// unexpectedEOF returns io.ErrUnexpectedEOF, located at the last lexeme read if any
+func (odp *onDemandParser) unexpectedEOF() error {
+	if odp.last == nil {
+		return io.ErrUnexpectedEOF
+	}
+	return &lexer.Error{Pos: odp.last.Pos, Snippet: odp.last.Value, Err: io.ErrUnexpectedEOF}
+}
+
 // Close closes an onDemandParser.
 // It does NOT close the included lexer.
 func (odp *onDemandParser) Close() error {
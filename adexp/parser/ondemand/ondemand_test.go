@@ -5,8 +5,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aabizri/aero/adexp/lexer"
 	"github.com/aabizri/aero/adexp/lexer/ondemand"
 	"github.com/aabizri/aero/adexp/lexer/scannify"
+	"github.com/aabizri/aero/adexp/parser"
+
+	"github.com/pkg/errors"
 )
 
 const testString = " -TITLE SAM -ARCID AFR 456 -IFPLID XX11111111 -ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0900 -CTOT 0930 -REGUL XXXXXXX -REGCAUSE XXXX -TAXITIME XXXXX -GEOID 01 -LATTD 520000N -LONGTD 0150000W -BEGIN ADDR -FAC LLEVZPZX -FAC LFFFZQZX -END ADDR"
@@ -34,3 +38,115 @@ Loop:
 		t.Logf("Got for expression %d:\nKind: \t\t%s\nKeyword: \t%s (len %d)\nValue: \t\t%v", i, expr.Kind, expr.Keyword, len(expr.Keyword), expr.Value)
 	}
 }
+
+func TestParser_Structured(t *testing.T) {
+	const text = "-TITLE SAM -GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W -ADEP LFPG -BEGIN RTEPTS -PT -PTID XETBO -FL F350 -ETO 140110093000 -PT -PTID BUBLI -ETO 140110094000 -END RTEPTS"
+	lexScanner := scannify.New(ondemand.New(strings.NewReader(text)))
+	p := New(lexScanner)
+
+	var exprs []*parser.Expression
+	for {
+		expr, err := p.Parse()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("got error: %v", err)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	if len(exprs) != 4 {
+		t.Fatalf("expected 4 expressions, got %d: %v", len(exprs), exprs)
+	}
+
+	// GEO
+	geo := exprs[1]
+	sf, ok := geo.Value.(parser.StructuredField)
+	if geo.Kind != parser.Structured || !ok {
+		t.Fatalf("expected GEO to be a structured field, got a %s (%T)", geo.Kind, geo.Value)
+	}
+	if len(sf) != 3 || sf[0].Keyword != "GEOID" || sf[2].Keyword != "LONGTD" || sf[2].Value != parser.PrimaryField("0150000W") {
+		t.Errorf("unexpected GEO subfields: %v", sf)
+	}
+
+	// The structured field shouldn't have eaten the next primary field
+	if exprs[2].Keyword != "ADEP" || exprs[2].Value != parser.PrimaryField("LFPG") {
+		t.Errorf("expected ADEP primary field after GEO, got %v", exprs[2])
+	}
+
+	// RTEPTS
+	lf, ok := exprs[3].Value.(parser.ListField)
+	if exprs[3].Kind != parser.List || !ok {
+		t.Fatalf("expected RTEPTS to be a list field, got a %s (%T)", exprs[3].Kind, exprs[3].Value)
+	}
+	if len(lf) != 2 {
+		t.Fatalf("expected 2 points in RTEPTS, got %d: %v", len(lf), lf)
+	}
+	pt, ok := lf[0].Value.(parser.StructuredField)
+	if lf[0].Kind != parser.Structured || !ok {
+		t.Fatalf("expected PT to be a structured field, got a %s (%T)", lf[0].Kind, lf[0].Value)
+	}
+	if len(pt) != 3 || pt[0].Value != parser.PrimaryField("XETBO") || pt[1].Keyword != "FL" || pt[1].Value != parser.PrimaryField("F350") {
+		t.Errorf("unexpected PT subfields: %v", pt)
+	}
+}
+
+// lexemes is a lexer.LexReader returning the given lexemes, as a lexer not checking that the input is complete would
+type lexemes []lexer.Lexeme
+
+// ReadLex implements lexer.LexReader
+func (l *lexemes) ReadLex() (*lexer.Lexeme, error) {
+	if len(*l) == 0 {
+		return nil, io.EOF
+	}
+	lex := (*l)[0]
+	*l = (*l)[1:]
+	return &lex, nil
+}
+
+func TestParser_UnexpectedEOF(t *testing.T) {
+	title := []lexer.Lexeme{
+		{Kind: lexer.LexemeKeyword, Value: "TITLE", Pos: lexer.Pos{Offset: 0, Line: 1, Column: 1}},
+		{Kind: lexer.LexemeValue, Value: "IFPL", Pos: lexer.Pos{Offset: 7, Line: 1, Column: 8}},
+	}
+	tests := []struct {
+		lexemes []lexer.Lexeme
+	}{
+		// -GEO -GEOID
+		{lexemes: []lexer.Lexeme{
+			{Kind: lexer.LexemeKeyword, Value: "GEO", Pos: lexer.Pos{Offset: 12, Line: 1, Column: 13}},
+			{Kind: lexer.LexemeKeyword, Value: "GEOID", Pos: lexer.Pos{Offset: 17, Line: 1, Column: 18}},
+		}},
+		// -BEGIN RTEPTS -PT -PTID XETBO
+		{lexemes: []lexer.Lexeme{
+			{Kind: lexer.LexemeBEGIN, Value: "BEGIN", Pos: lexer.Pos{Offset: 12, Line: 1, Column: 13}},
+			{Kind: lexer.LexemeKeyword, Value: "RTEPTS", Pos: lexer.Pos{Offset: 19, Line: 1, Column: 20}},
+			{Kind: lexer.LexemeKeyword, Value: "PT", Pos: lexer.Pos{Offset: 26, Line: 1, Column: 27}},
+			{Kind: lexer.LexemeKeyword, Value: "PTID", Pos: lexer.Pos{Offset: 30, Line: 1, Column: 31}},
+			{Kind: lexer.LexemeValue, Value: "XETBO", Pos: lexer.Pos{Offset: 36, Line: 1, Column: 37}},
+		}},
+		// -BEGIN RTEPTS
+		{lexemes: []lexer.Lexeme{
+			{Kind: lexer.LexemeBEGIN, Value: "BEGIN", Pos: lexer.Pos{Offset: 12, Line: 1, Column: 13}},
+			{Kind: lexer.LexemeKeyword, Value: "RTEPTS", Pos: lexer.Pos{Offset: 19, Line: 1, Column: 20}},
+		}},
+	}
+	for _, test := range tests {
+		last := test.lexemes[len(test.lexemes)-1]
+		input := lexemes(append(append([]lexer.Lexeme{}, title...), test.lexemes...))
+		p := New(scannify.New(&input))
+
+		var err error
+		for err == nil {
+			_, err = p.Parse()
+		}
+		le, ok := errors.Cause(err).(*lexer.Error)
+		if !ok {
+			t.Errorf("%s: expected a *lexer.Error, got %v", last.Value, err)
+			continue
+		}
+		if le.Err != io.ErrUnexpectedEOF || le.Pos != last.Pos || le.Snippet != last.Value {
+			t.Errorf("%s: got %v at %s, expected %v at %s", last.Value, le.Err, le.Pos, io.ErrUnexpectedEOF, last.Pos)
+		}
+	}
+}
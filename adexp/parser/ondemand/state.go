@@ -3,6 +3,7 @@ package ondemand
 import (
 	"io"
 
+	"github.com/aabizri/aero/adexp/catalog"
 	"github.com/aabizri/aero/adexp/lexer"
 	"github.com/aabizri/aero/adexp/parser"
 	"github.com/pkg/errors"
@@ -10,10 +11,15 @@ import (
 
 type stateFn func(*onDemandParser) (*parser.Expression, stateFn, error)
 
+// errorAt returns an error located at the given lexeme
+func errorAt(lex *lexer.Lexeme, format string, args ...interface{}) error {
+	return &lexer.Error{Pos: lex.Pos, Snippet: lex.Value, Err: errors.Errorf(format, args...)}
+}
+
 // startState awaits a "TITLE" basic field.
 func startState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 	// Retrieve the next lexeme
-	lex, err := odp.lexer.ReadLex()
+	lex, err := odp.readLex()
 	if err == io.EOF {
 		return nil, nil, err
 	} else if err != nil {
@@ -22,15 +28,15 @@ func startState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 
 	// If that lexeme isn't a keyword,  return an error
 	if lex.Kind != lexer.LexemeKeyword {
-		return nil, nil, errors.Wrapf(err, "startState: expected a keyword as first element, got a %s instead", lex.Kind.String())
+		return nil, nil, errorAt(lex, "startState: expected a keyword as first element, got a %s instead", lex.Kind.String())
 	} else if lex.Value != parser.TITLEKeyword {
-		return nil, nil, errors.Wrapf(err, "first field encontered is not \"%s\" but \"%s\"", parser.TITLEKeyword, lex.Value)
+		return nil, nil, errorAt(lex, "startState: first field encontered is not \"%s\" but \"%s\"", parser.TITLEKeyword, lex.Value)
 	}
 
 	// Retrieve the value
-	lex, err = odp.lexer.ReadLex()
+	lex, err = odp.readLex()
 	if err == io.EOF {
-		return nil, nil, io.ErrUnexpectedEOF
+		return nil, nil, odp.unexpectedEOF()
 	} else if err != nil {
 		return nil, nil, errors.Wrap(err, "startState: error while retrieving next lexeme")
 	}
@@ -48,7 +54,7 @@ func startState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 // normalState is the normal state, i.e not currently in a field
 func normalState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 	// Retrieve the next lexeme
-	lex, err := odp.lexer.ReadLex()
+	lex, err := odp.readLex()
 	if err == io.EOF {
 		return nil, nil, err
 	} else if err != nil {
@@ -57,7 +63,7 @@ func normalState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 
 	// If that lexeme isn't a keyword nor a BEGIN,  return an error
 	if lex.Kind != lexer.LexemeKeyword && lex.Kind != lexer.LexemeBEGIN {
-		return nil, nil, errors.Wrapf(err, "normalState: expected a keyword as first element, got a %s instead", lex.Kind.String())
+		return nil, nil, errorAt(lex, "normalState: expected a keyword as first element, got a %s instead", lex.Kind.String())
 	}
 
 	// If we enconter a BEGIN, launch the beginState
@@ -74,7 +80,7 @@ func normalState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 func nonListState(keyword string) stateFn {
 	return func(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 		// Retrieve the next lexeme
-		lex, err := odp.lexer.ReadLex()
+		lex, err := odp.readLex()
 		if err == io.EOF {
 			return nil, nil, err
 		} else if err != nil {
@@ -83,7 +89,7 @@ func nonListState(keyword string) stateFn {
 
 		// If that lexeme is neither a keyword nor a value, we return an error !
 		if lex.Kind != lexer.LexemeKeyword && lex.Kind != lexer.LexemeValue {
-			return nil, nil, errors.Errorf("nonListState: unexpected lexeme of kind \"%s\" instead of expected Keyword or Value", lex.Kind.String())
+			return nil, nil, errorAt(lex, "nonListState: unexpected lexeme of kind \"%s\" instead of expected Keyword or Value", lex.Kind.String())
 		}
 
 		// Establish the expression
@@ -93,18 +99,22 @@ func nonListState(keyword string) stateFn {
 
 		// If that lexeme is a keyword, then we have a subField
 		// So we call parseSubField and return the returned value
-		if lex.Kind == lexer.LexemeKeyword { /*
-				err := odp.lexer.UnreadLex()
-				if err != nil {
-					return nil, nil, errors.Wrap(err, "nonListState: error while unreading last lexeme")
-				}
-				value, err := parseSubField(odp.lexer)
-				if err != nil {
-					return nil, nil, errors.Wrap(err, "nonListState: error in parseSubField")
-				}
-				expr.Value = value
-			*/
+		if lex.Kind == lexer.LexemeKeyword {
+			if !catalog.Allowed(keyword, lex.Value) {
+				return nil, nil, errorAt(lex, "nonListState: keyword \"%s\" followed by keyword \"%s\" which isn't one of its subfields", keyword, lex.Value)
+			}
+			err := odp.lexer.UnreadLex()
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "nonListState: error while unreading last lexeme")
+			}
+			value, err := parseSubField(odp, keyword)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "nonListState: error in parseSubField")
+			}
+			expr.Kind = parser.Structured
+			expr.Value = value
 		} else { // Else it's a basic field, so we assign it
+			expr.Kind = parser.Primary
 			expr.Value = parser.PrimaryField(lex.Value)
 		}
 
@@ -112,29 +122,79 @@ func nonListState(keyword string) stateFn {
 	}
 }
 
-// And parseSubField which starts off in a normalState, except we catch the expressions and put them in a parser.SubField
-// THIS WILL NOT WORK UNTIL WE HAVE A LIST OF SUBFIELD-ENABLED KEYWORD AS WE NEED TO STOP AT SOME POINT
-/*
-func parseSubField(l lexer.LexScanner) (parser.StructuredField, error) {
-	// Create a new parser
-	p := New(l)
-	values := make(parser.StructuredField)
+// parseSubField parses the subfields of the given structured keyword.
+// It stops at the first lexeme that isn't one of its subfields, which is left unread.
+func parseSubField(odp *onDemandParser, keyword string) (parser.StructuredField, error) {
+	values := make(parser.StructuredField, 0)
 	for i := 0; ; i++ {
-		expr, err := p.Parse()
-		if err != nil {
-			return errors.Wrapf(err, "parseSubField: error in pass %d while parsing", i)
+		lex, err := odp.readLex()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "parseSubField (pass #%d): error while retrieving next lexeme", i)
 		}
 
+		// If this isn't one of our subfields, the structured field is over
+		if lex.Kind != lexer.LexemeKeyword || !catalog.Allowed(keyword, lex.Value) {
+			err = odp.lexer.UnreadLex()
+			if err != nil {
+				return nil, errors.Wrapf(err, "parseSubField (pass #%d): error while unreading lexeme", i)
+			}
+			break
+		}
+		expr := parser.Expression{
+			Keyword: lex.Value,
+		}
+
+		// Retrieve its value
+		lex, err = odp.readLex()
+		if err == io.EOF {
+			return nil, odp.unexpectedEOF()
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "parseSubField (pass #%d): error while retrieving value of subfield %s", i, expr.Keyword)
+		}
+
+		switch lex.Kind {
+		// A value means a primary subfield
+		case lexer.LexemeValue:
+			expr.Kind = parser.Primary
+			expr.Value = parser.PrimaryField(lex.Value)
+
+		// A keyword means an embedded structured subfield
+		case lexer.LexemeKeyword:
+			if !catalog.Allowed(expr.Keyword, lex.Value) {
+				return nil, errorAt(lex, "parseSubField (pass #%d): subfield \"%s\" followed by keyword \"%s\" which isn't one of its subfields", i, expr.Keyword, lex.Value)
+			}
+			err = odp.lexer.UnreadLex()
+			if err != nil {
+				return nil, errors.Wrapf(err, "parseSubField (pass #%d): error while unreading lexeme", i)
+			}
+			value, err := parseSubField(odp, expr.Keyword)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parseSubField (pass #%d): error while parsing subfield %s", i, expr.Keyword)
+			}
+			expr.Kind = parser.Structured
+			expr.Value = value
+
+		default:
+			return nil, errorAt(lex, "parseSubField (pass #%d): unexpected lexeme of kind \"%s\" as value of subfield %s", i, lex.Kind.String(), expr.Keyword)
+		}
+
+		values = append(values, expr)
 	}
-}*/
+
+	return values, nil
+}
 
 func parseListInternals(odp *onDemandParser) (parser.ListField, error) {
 	// Create a new parser
 	values := make(parser.ListField, 0)
 	var state stateFn = normalState
 	for i := 0; ; i++ {
-		lex, err := odp.lexer.ReadLex()
-		if err != nil {
+		lex, err := odp.readLex()
+		if err == io.EOF {
+			return nil, odp.unexpectedEOF()
+		} else if err != nil {
 			return nil, errors.Wrapf(err, "parseListInternals (pass #%d): error while retrieving next lexeme", i)
 		}
 
@@ -147,7 +207,7 @@ func parseListInternals(odp *onDemandParser) (parser.ListField, error) {
 		// It isn't, so we unread and let NormalState manage it
 		err = odp.lexer.UnreadLex()
 		if err != nil {
-			return nil, errors.Wrapf(err, "parseListInternals (pass %#d): error while unreading lexeme", i)
+			return nil, errors.Wrapf(err, "parseListInternals (pass #%d): error while unreading lexeme", i)
 		}
 
 		//Launch
@@ -156,7 +216,7 @@ func parseListInternals(odp *onDemandParser) (parser.ListField, error) {
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return nil, errors.Wrapf(err, "parseListInternals: (pass %#d): error while launching state", i)
+			return nil, errors.Wrapf(err, "parseListInternals: (pass #%d): error while launching state", i)
 		}
 
 		if expr != nil {
@@ -170,7 +230,7 @@ func parseListInternals(odp *onDemandParser) (parser.ListField, error) {
 // In listState we expect a list, starting with BEGIN <keyword> [....] END <keyword>
 func listState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 	// Retrieve the next lexeme
-	lex, err := odp.lexer.ReadLex()
+	lex, err := odp.readLex()
 	if err == io.EOF {
 		return nil, nil, err
 	} else if err != nil {
@@ -179,20 +239,20 @@ func listState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 
 	// If that lexeme isn't a BEGIN,  return an error
 	if lex.Kind != lexer.LexemeBEGIN {
-		return nil, nil, errors.Errorf("listState: expected BEGIN as first element, got a %s instead", lex.Kind.String())
+		return nil, nil, errorAt(lex, "listState: expected BEGIN as first element, got a %s instead", lex.Kind.String())
 	}
 
 	// Retrieve the associated keyword
-	lex, err = odp.lexer.ReadLex()
+	lex, err = odp.readLex()
 	if err == io.EOF {
-		return nil, nil, io.ErrUnexpectedEOF
+		return nil, nil, odp.unexpectedEOF()
 	} else if err != nil {
 		return nil, nil, errors.Wrap(err, "listState: error while retrieving associated keyword")
 	}
 
 	// If that isn't a keyword, return an error
 	if lex.Kind != lexer.LexemeKeyword {
-		return nil, nil, errors.Errorf("listState: expected a keyword following a BEGIN, got a %s instead", lex.Kind.String())
+		return nil, nil, errorAt(lex, "listState: expected a keyword following a BEGIN, got a %s instead", lex.Kind.String())
 	}
 
 	// Create the expression
@@ -210,33 +270,33 @@ func listState(odp *onDemandParser) (*parser.Expression, stateFn, error) {
 	}
 
 	// We now expect an END
-	lex, err = odp.lexer.ReadLex()
+	lex, err = odp.readLex()
 	if err == io.EOF {
-		return nil, nil, io.ErrUnexpectedEOF
+		return nil, nil, odp.unexpectedEOF()
 	} else if err != nil {
 		return nil, nil, errors.Wrap(err, "listState: error while retrieving expected END lexeme")
 	}
 
 	if lex.Kind != lexer.LexemeEND {
-		return nil, nil, errors.Errorf("listState: expected an END statement, got a %s instead", lex.Kind.String())
+		return nil, nil, errorAt(lex, "listState: expected an END statement, got a %s instead", lex.Kind.String())
 	}
 
 	// And now a keyword
-	lex, err = odp.lexer.ReadLex()
+	lex, err = odp.readLex()
 	if err == io.EOF {
-		return nil, nil, io.ErrUnexpectedEOF
+		return nil, nil, odp.unexpectedEOF()
 	} else if err != nil {
 		return nil, nil, errors.Wrap(err, "listState: error while retrieving expected END lexeme")
 	}
 
 	// Check if this is a keyword
 	if lex.Kind != lexer.LexemeKeyword {
-		return nil, nil, errors.Errorf("listState: expected a keyword after an END lexeme, got a %s instead", lex.Kind.String())
+		return nil, nil, errorAt(lex, "listState: expected a keyword after an END lexeme, got a %s instead", lex.Kind.String())
 	}
 
 	// Check if the keyword is the same
 	if lex.Value != expr.Keyword {
-		return nil, nil, errors.Errorf("listState: list's associated keyword not consistent (BEGIN has %s , END has %s)", expr.Keyword, lex.Value)
+		return nil, nil, errorAt(lex, "listState: list's associated keyword not consistent (BEGIN has %s , END has %s)", expr.Keyword, lex.Value)
 	}
 
 	// Return
@@ -39,8 +39,8 @@ type Value interface{}
 type (
 	// A PrimaryField has only a text value
 	PrimaryField string
-	// A StructuredField has several subfields, but it isn't a list
-	StructuredField map[string]Expression
+	// A StructuredField has several subfields, in order and possibly sharing a keyword, but it isn't a list
+	StructuredField []Expression
 	// A ListField is a list of subfields
 	ListField []Expression
 )
@@ -0,0 +1,89 @@
+package adexp
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/pkg/errors"
+)
+
+// lookup follows the path from the structured or list field val, see ADEXP.Lookup
+func lookup(val value, path string) (Field, error) {
+	if path == "" {
+		return Field{}, errors.New("empty path")
+	}
+
+	var f Field
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		keyword, index, err := parseSegment(seg)
+		if err != nil {
+			return Field{}, errors.Wrapf(err, "segment #%d", i)
+		}
+		at := strings.Join(segments[:i+1], "/")
+
+		if val.kind == Primary {
+			return Field{}, errors.Wrapf(ErrFieldNotFound, "%s: %s is a primary field", at, f.Keyword)
+		}
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return Field{}, errors.Wrapf(ErrInconsistentValue, "%s: %s holds a %T", at, f.Keyword, val.value)
+		}
+		switch val.kind {
+		case Structured:
+			if index != -1 {
+				return Field{}, errors.Errorf("%s: only list elements can be indexed", at)
+			}
+			if val, ok = mul.m[keyword]; !ok {
+				return Field{}, errors.Wrapf(ErrFieldNotFound, "%s: no such subfield", at)
+			}
+
+		case List:
+			if index == -1 {
+				index = 0
+			}
+			if val, ok = nth(mul.items, keyword, index); !ok {
+				return Field{}, errors.Wrapf(ErrFieldNotFound, "%s: no such element", at)
+			}
+
+		default:
+			return Field{}, errors.Errorf("%s: unknown kind %d", at, val.kind)
+		}
+		f = Field{Keyword: keyword, value: val}
+	}
+	return f, nil
+}
+
+// parseSegment parses a segment of a path, such as "PT" or "PT[1]", index being -1 if there is none
+func parseSegment(seg string) (keyword string, index int, err error) {
+	keyword, index = seg, -1
+	if i := strings.IndexByte(seg, '['); i != -1 {
+		if !strings.HasSuffix(seg, "]") {
+			return "", 0, errors.Errorf("unterminated index in %q", seg)
+		}
+		keyword = seg[:i]
+		index, err = strconv.Atoi(seg[i+1 : len(seg)-1])
+		if err != nil || index < 0 {
+			return "", 0, errors.Errorf("invalid index in %q", seg)
+		}
+	}
+	if err := lexer.CheckKeyword(keyword); err != nil {
+		return "", 0, err
+	}
+	return keyword, index, nil
+}
+
+// nth returns the value of the element #n among the elements with the given keyword
+func nth(items []entry, keyword string, n int) (value, bool) {
+	for _, e := range items {
+		if e.keyword != keyword {
+			continue
+		}
+		if n == 0 {
+			return e.value, true
+		}
+		n--
+	}
+	return value{}, false
+}
@@ -0,0 +1,389 @@
+// Package query compiles path expressions and evaluates them against ADEXP messages.
+//
+// A path is made of steps separated by slashes, each step being a keyword or "*" for any keyword,
+// followed by any number of selectors between brackets:
+//
+//	RTEPTS/PT[3]/ETO        the ETO of the fourth point of the route
+//	ADDR/FAC[*]             every FAC of the address list
+//	GEO[GEOID=01]/LATTD     the latitude of the GEO whose GEOID is 01
+//	RTEPTS/PT[FL][0]/PTID   the identifier of the first point with a flight level
+//
+// A step without selectors matches the first field with its keyword, as Lookup does, or every field for "*".
+// The selectors then filter the matching fields in turn:
+//
+//	[n]           the field #n among those matched so far, starting at 0
+//	[*]           every field matched so far
+//	[PATH]        the fields in which PATH matches a field
+//	[PATH=VALUE]  the fields in which PATH matches a primary field whose value is VALUE
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aabizri/aero/adexp"
+	"github.com/aabizri/aero/adexp/lexer"
+
+	"github.com/pkg/errors"
+)
+
+// A Source is a set of fields a query is evaluated against: an adexp.ADEXP, an adexp.Document or an *adexp.Multi
+type Source interface {
+	Range(f func(field adexp.Field) bool)
+}
+
+// A Query is a compiled path, safe for concurrent use
+type Query struct {
+	path  string
+	steps []step
+}
+
+// step is a step of a path, its keyword being empty for "*"
+type step struct {
+	keyword   string
+	selectors []selector
+}
+
+// selectorKind is the kind of a selector
+type selectorKind int
+
+const (
+	selectAll selectorKind = iota
+	selectIndex
+	selectPredicate
+)
+
+// selector filters the fields matched by a step
+type selector struct {
+	kind  selectorKind
+	index int
+
+	// For predicates, with value only being compared if hasValue is true
+	pred     *Query
+	value    string
+	hasValue bool
+}
+
+// node is a field matched during an evaluation, along with its path
+type node struct {
+	field adexp.Field
+	path  string
+}
+
+// Compile compiles the path
+func Compile(path string) (*Query, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Compile %q", path)
+	}
+	return &Query{path: path, steps: steps}, nil
+}
+
+// MustCompile is like Compile but panics if the path cannot be compiled
+func MustCompile(path string) *Query {
+	q, err := Compile(path)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// String returns the path of the query
+func (q *Query) String() string {
+	return q.path
+}
+
+// Eval returns the fields of src matched by the query, in order
+func (q *Query) Eval(src Source) []Result {
+	nodes := q.eval(src)
+	results := make([]Result, len(nodes))
+	for i, n := range nodes {
+		results[i] = Result{Field: n.field, Path: n.path}
+	}
+	return results
+}
+
+// First returns the first field of src matched by the query.
+// If there is none, the error is caused by adexp.ErrFieldNotFound.
+func (q *Query) First(src Source) (Result, error) {
+	results := q.Eval(src)
+	if len(results) == 0 {
+		return Result{}, errors.Wrapf(adexp.ErrFieldNotFound, "First: no match for %s", q.path)
+	}
+	return results[0], nil
+}
+
+// eval evaluates the query, step by step, from src
+func (q *Query) eval(src Source) []node {
+	var nodes []node
+	sources := []node{{}}
+	for i, s := range q.steps {
+		nodes = nil
+		for _, parent := range sources {
+			from := src
+			if i != 0 {
+				from = sourceOf(parent.field)
+			}
+			nodes = append(nodes, s.apply(children(from, parent.path))...)
+		}
+
+		// Primary fields have no subfields to go on with
+		sources = sources[:0]
+		for _, n := range nodes {
+			if sourceOf(n.field) != nil {
+				sources = append(sources, n)
+			}
+		}
+	}
+	return nodes
+}
+
+// sourceOf returns the subfields of a structured field or the elements of a list field, nil for a primary field
+func sourceOf(f adexp.Field) Source {
+	if mul, ok := f.Structured(); ok {
+		return mul
+	}
+	if mul, ok := f.List(); ok {
+		return mul
+	}
+	return nil
+}
+
+// children returns the fields of src, each field being indexed in its path if it is an element of a list or if its keyword is repeated
+func children(src Source, path string) []node {
+	var fields []adexp.Field
+	src.Range(func(f adexp.Field) bool {
+		fields = append(fields, f)
+		return true
+	})
+
+	mul, list := src.(*adexp.Multi)
+	list = list && mul.Kind() == adexp.List
+	count := make(map[string]int, len(fields))
+	for _, f := range fields {
+		count[f.Keyword]++
+	}
+
+	seen := make(map[string]int, len(count))
+	nodes := make([]node, len(fields))
+	for i, f := range fields {
+		p := f.Keyword
+		if list || count[f.Keyword] > 1 {
+			p = fmt.Sprintf("%s[%d]", f.Keyword, seen[f.Keyword])
+		}
+		seen[f.Keyword]++
+		if path != "" {
+			p = path + "/" + p
+		}
+		nodes[i] = node{field: f, path: p}
+	}
+	return nodes
+}
+
+// apply returns the nodes matched by the step
+func (s step) apply(nodes []node) []node {
+	var matched []node
+	for _, n := range nodes {
+		if s.keyword == "" || n.field.Keyword == s.keyword {
+			matched = append(matched, n)
+		}
+	}
+	if len(s.selectors) == 0 && s.keyword != "" && len(matched) > 1 {
+		matched = matched[:1]
+	}
+	for _, sel := range s.selectors {
+		matched = sel.filter(matched)
+	}
+	return matched
+}
+
+// filter returns the nodes selected
+func (sel selector) filter(nodes []node) []node {
+	switch sel.kind {
+	case selectIndex:
+		if sel.index >= len(nodes) {
+			return nil
+		}
+		return nodes[sel.index : sel.index+1]
+	case selectPredicate:
+		var filtered []node
+		for _, n := range nodes {
+			if sel.matches(n.field) {
+				filtered = append(filtered, n)
+			}
+		}
+		return filtered
+	default:
+		return nodes
+	}
+}
+
+// matches reports whether the predicate holds for the field
+func (sel selector) matches(f adexp.Field) bool {
+	src := sourceOf(f)
+	if src == nil {
+		return false
+	}
+	for _, n := range sel.pred.eval(src) {
+		if !sel.hasValue {
+			return true
+		}
+		if val, ok := n.field.Primary(); ok && val == sel.value {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePath parses the steps of a path
+func parsePath(path string) ([]step, error) {
+	if path == "" {
+		return nil, errors.New("empty path")
+	}
+	segments, err := split(path)
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]step, len(segments))
+	for i, seg := range segments {
+		if steps[i], err = parseStep(seg); err != nil {
+			return nil, errors.Wrapf(err, "step #%d", i)
+		}
+	}
+	return steps, nil
+}
+
+// split splits the path at the slashes which aren't between brackets
+func split(path string) ([]string, error) {
+	var (
+		segments []string
+		depth    int
+		start    int
+	)
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth == 0 {
+				return nil, errors.Errorf("unexpected ']' at offset %d", i)
+			}
+			depth--
+		case '/':
+			if depth == 0 {
+				segments = append(segments, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unterminated selector")
+	}
+	return append(segments, path[start:]), nil
+}
+
+// parseStep parses a step, such as "PT", "*" or "PT[GEOID=01][0]"
+func parseStep(seg string) (step, error) {
+	i := strings.IndexByte(seg, '[')
+	if i == -1 {
+		i = len(seg)
+	}
+	var s step
+	if name := seg[:i]; name != "*" {
+		if err := lexer.CheckKeyword(name); err != nil {
+			return step{}, err
+		}
+		s.keyword = name
+	}
+
+	// The brackets are known to be balanced
+	for rest := seg[i:]; rest != ""; {
+		if rest[0] != '[' {
+			return step{}, errors.Errorf("unexpected %q after a selector in %q", rest, seg)
+		}
+		end := closing(rest)
+		sel, err := parseSelector(rest[1:end])
+		if err != nil {
+			return step{}, errors.Wrapf(err, "selector %q", rest[:end+1])
+		}
+		s.selectors = append(s.selectors, sel)
+		rest = rest[end+1:]
+	}
+	return s, nil
+}
+
+// closing returns the offset of the bracket closing the one str starts with
+func closing(str string) int {
+	depth := 0
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '[':
+			depth++
+		case ']':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseSelector parses the content of a selector, such as "3", "*" or "GEOID=01"
+func parseSelector(str string) (selector, error) {
+	switch {
+	case str == "":
+		return selector{}, errors.New("empty selector")
+	case str == "*":
+		return selector{kind: selectAll}, nil
+	case isNumber(str):
+		index, err := strconv.Atoi(str)
+		if err != nil {
+			return selector{}, errors.Wrap(err, "invalid index")
+		}
+		return selector{kind: selectIndex, index: index}, nil
+	}
+
+	sel := selector{kind: selectPredicate}
+	path := str
+	if i := equalSign(str); i != -1 {
+		path, sel.value, sel.hasValue = str[:i], str[i+1:], true
+		if sel.value == "" {
+			return selector{}, errors.New("empty value")
+		}
+	}
+	steps, err := parsePath(path)
+	if err != nil {
+		return selector{}, err
+	}
+	sel.pred = &Query{path: path, steps: steps}
+	return sel, nil
+}
+
+// equalSign returns the offset of the first '=' which isn't between brackets, -1 if there is none
+func equalSign(str string) int {
+	depth := 0
+	for i := 0; i < len(str); i++ {
+		switch str[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '=':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// isNumber reports whether str is only made of digits
+func isNumber(str string) bool {
+	for _, r := range str {
+		if !lexer.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
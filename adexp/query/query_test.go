@@ -0,0 +1,130 @@
+package query
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aabizri/aero/adexp"
+
+	"github.com/pkg/errors"
+)
+
+const testText = "-TITLE IFPL -ARCID AFR456 -BEGIN ADDR -FAC LFPGZQZX -FAC LFFFZQZX -FAC EUCHZMFP -END ADDR " +
+	"-GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W -GEO -GEOID 02 -LATTD 510000N -LONGTD 0140000W " +
+	"-BEGIN RTEPTS -PT -PTID XETBO -FL F350 -ETO 140110093000 -PT -PTID BUBLI -ETO 140110094000 " +
+	"-PT -PTID 01 -FL F370 -ETO 140110095000 -PT -PTID LIMRI -FL F370 -ETO 140110100500 -END RTEPTS"
+
+// decodeDocument decodes testText
+func decodeDocument(t *testing.T) adexp.Document {
+	var doc adexp.Document
+	if err := doc.UnmarshalText([]byte(testText)); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	return doc
+}
+
+func TestQuery_Eval(t *testing.T) {
+	doc := decodeDocument(t)
+	tests := []struct {
+		path     string
+		expected []string // expected is the path and value of each result
+	}{
+		{"ARCID", []string{"ARCID AFR456"}},
+		{"RTEPTS/PT[3]/ETO", []string{"RTEPTS/PT[3]/ETO 140110100500"}},
+		{"RTEPTS/PT/PTID", []string{"RTEPTS/PT[0]/PTID XETBO"}},
+		{"RTEPTS/PT[4]/ETO", nil},
+		{"ADDR/FAC[*]", []string{"ADDR/FAC[0] LFPGZQZX", "ADDR/FAC[1] LFFFZQZX", "ADDR/FAC[2] EUCHZMFP"}},
+		{"ADDR/*[1]", []string{"ADDR/FAC[1] LFFFZQZX"}},
+		{"GEO/LATTD", []string{"GEO[0]/LATTD 520000N"}},
+		{"GEO[GEOID=02]/LATTD", []string{"GEO[1]/LATTD 510000N"}},
+		{"GEO[GEOID=03]/LATTD", nil},
+		{"RTEPTS/PT[FL]/PTID", []string{"RTEPTS/PT[0]/PTID XETBO", "RTEPTS/PT[2]/PTID 01", "RTEPTS/PT[3]/PTID LIMRI"}},
+		{"RTEPTS/PT[FL=F370][0]/PTID", []string{"RTEPTS/PT[2]/PTID 01"}},
+		{"RTEPTS/PT[PTID=01]/ETO", []string{"RTEPTS/PT[2]/ETO 140110095000"}},
+		{"*[GEOID=01]/*", []string{"GEO[0]/GEOID 01", "GEO[0]/LATTD 520000N", "GEO[0]/LONGTD 0150000W"}},
+		{"ARCID/X", nil},
+	}
+	for _, test := range tests {
+		var got []string
+		for _, r := range MustCompile(test.path).Eval(doc) {
+			val, err := r.Text()
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.path, err)
+			}
+			got = append(got, r.Path+" "+val)
+		}
+		if strings.Join(got, ", ") != strings.Join(test.expected, ", ") {
+			t.Errorf("%s: got %v, expected %v", test.path, got, test.expected)
+		}
+	}
+}
+
+func TestQuery_Eval_Multi(t *testing.T) {
+	msg := decodeDocument(t).Map()
+	rtepts, ok := msg.GetList("RTEPTS")
+	if !ok {
+		t.Fatalf("RTEPTS not found")
+	}
+	r, err := MustCompile("PT[PTID=BUBLI]/ETO").First(rtepts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eto, err := r.Time()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := time.Date(2014, 1, 10, 9, 40, 0, 0, time.UTC); !eto.Equal(expected) || r.Path != "PT[1]/ETO" {
+		t.Errorf("got %s at %s, expected %s at PT[1]/ETO", eto, r.Path, expected)
+	}
+
+	// The map keeps the last GEO
+	r, err = MustCompile("GEO/LATTD").First(msg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Path != "GEO/LATTD" {
+		t.Errorf("unexpected path %s", r.Path)
+	}
+	if _, err := MustCompile("GEO[GEOID=01]").First(msg); errors.Cause(err) != adexp.ErrFieldNotFound {
+		t.Errorf("expected adexp.ErrFieldNotFound, got %v", err)
+	}
+}
+
+func TestResult(t *testing.T) {
+	doc := decodeDocument(t)
+	fl, err := MustCompile("RTEPTS/PT[3]/FL").Eval(doc)[0].FlightLevel()
+	if err != nil || fl.Value != 370 {
+		t.Errorf("unexpected flight level %+v (%v)", fl, err)
+	}
+	pt, err := MustCompile("RTEPTS/PT[PTID=01]/PTID").First(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n, err := pt.Int(); err != nil || n != 1 {
+		t.Errorf("got %d (%v), expected 1", n, err)
+	}
+	geo, err := MustCompile("GEO").First(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := geo.Text(); err == nil {
+		t.Errorf("expected an error for a structured field")
+	}
+	if _, ok := geo.Structured(); !ok {
+		t.Errorf("GEO isn't structured")
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	for _, path := range []string{"", "RTEPTS//PT", "PT[", "PT]", "PT[]", "PT[0]X", "pt", "PT[GEOID=]", "PT[=01]", "PT[-1]", "P-T"} {
+		if _, err := Compile(path); err == nil {
+			t.Errorf("%q: expected an error", path)
+		}
+	}
+	for _, path := range []string{"*", "*[*]/*[0]", "GEO[GEOID]", "PT[REF[PTID=A]/FL=F350]", "RMK[TXT=A=B]"} {
+		if _, err := Compile(path); err != nil {
+			t.Errorf("%q: unexpected error: %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aabizri/aero/adexp"
+
+	"github.com/pkg/errors"
+)
+
+// A Result is a field matched by a query, along with its path in the source
+type Result struct {
+	adexp.Field
+
+	// Path is the path of the field, the elements of lists and the repeated fields being indexed, such as "RTEPTS/PT[3]/ETO"
+	Path string
+}
+
+// Text returns the value of a primary field
+func (r Result) Text() (string, error) {
+	val, ok := r.Primary()
+	if !ok {
+		return "", errors.Errorf("Text: %s is a %s, not a primary field", r.Path, r.Kind())
+	}
+	return val, nil
+}
+
+// Int returns the value of a primary field as an integer
+func (r Result) Int() (int, error) {
+	str, err := r.Text()
+	if err != nil {
+		return 0, errors.Wrap(err, "Int")
+	}
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Int: %s", r.Path)
+	}
+	return n, nil
+}
+
+// Float returns the value of a primary field as a floating-point number
+func (r Result) Float() (float64, error) {
+	str, err := r.Text()
+	if err != nil {
+		return 0, errors.Wrap(err, "Float")
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Float: %s", r.Path)
+	}
+	return f, nil
+}
+
+// Time returns the value of a primary field as a date, time or datetime, see adexp.ParseTime
+func (r Result) Time() (time.Time, error) {
+	str, err := r.Text()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Time")
+	}
+	t, err := adexp.ParseTime(str)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Time: %s", r.Path)
+	}
+	return t, nil
+}
+
+// FlightLevel returns the value of a primary field as a flight level
+func (r Result) FlightLevel() (adexp.FlightLevel, error) {
+	str, err := r.Text()
+	if err != nil {
+		return adexp.FlightLevel{}, errors.Wrap(err, "FlightLevel")
+	}
+	fl, err := adexp.ParseFlightLevel(str)
+	if err != nil {
+		return adexp.FlightLevel{}, errors.Wrapf(err, "FlightLevel: %s", r.Path)
+	}
+	return fl, nil
+}
+
+// Speed returns the value of a primary field as a speed
+func (r Result) Speed() (adexp.Speed, error) {
+	str, err := r.Text()
+	if err != nil {
+		return adexp.Speed{}, errors.Wrap(err, "Speed")
+	}
+	spd, err := adexp.ParseSpeed(str)
+	if err != nil {
+		return adexp.Speed{}, errors.Wrapf(err, "Speed: %s", r.Path)
+	}
+	return spd, nil
+}
+
+// Position returns the value of a primary field as a position, such as 520000N0150000W
+func (r Result) Position() (adexp.Position, error) {
+	str, err := r.Text()
+	if err != nil {
+		return adexp.Position{}, errors.Wrap(err, "Position")
+	}
+	pos, err := adexp.ParsePosition(str)
+	if err != nil {
+		return adexp.Position{}, errors.Wrapf(err, "Position: %s", r.Path)
+	}
+	return pos, nil
+}
@@ -0,0 +1,182 @@
+package adexp
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// tagName is the name of the struct tag used by Marshal & Unmarshal
+const tagName = "adexp"
+
+// fieldInfo describes how a struct field maps to an ADEXP field
+//
+// The tag is of the form `adexp:"KEYWORD[>ELEMENT][,option...]"`, where ELEMENT is the keyword of the elements of a list.
+// If there is no tag, the keyword is the upper-cased name of the field. A tag of "-" ignores the field.
+//
+// The options are "omitempty", and one of "date", "time", "datetime" or "seconds" to choose the format of a time.Time.
+type fieldInfo struct {
+	index      int
+	name       string
+	keyword    string
+	element    string
+	omitEmpty  bool
+	timeLayout string
+}
+
+// fieldCache caches the fields of the struct types already seen
+var fieldCache = struct {
+	sync.RWMutex
+	m map[reflect.Type][]fieldInfo
+}{m: make(map[reflect.Type][]fieldInfo)}
+
+// structFields returns the fields of a struct type that map to ADEXP fields, in declaration order
+func structFields(t reflect.Type) []fieldInfo {
+	fieldCache.RLock()
+	fields, ok := fieldCache.m[t]
+	fieldCache.RUnlock()
+	if ok {
+		return fields
+	}
+
+	fields = make([]fieldInfo, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		// Unexported fields are ignored
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		tag := sf.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+
+		fi := fieldInfo{
+			index:   i,
+			name:    sf.Name,
+			keyword: strings.ToUpper(sf.Name),
+		}
+
+		opts := strings.Split(tag, ",")
+		if name := opts[0]; name != "" {
+			if j := strings.IndexByte(name, '>'); j != -1 {
+				name, fi.element = name[:j], name[j+1:]
+			}
+			if name != "" {
+				fi.keyword = name
+			}
+		}
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "omitempty":
+				fi.omitEmpty = true
+			default:
+				if layout, ok := timeOptions[opt]; ok {
+					fi.timeLayout = layout
+				}
+			}
+		}
+
+		fields = append(fields, fi)
+	}
+
+	fieldCache.Lock()
+	fieldCache.m[t] = fields
+	fieldCache.Unlock()
+	return fields
+}
+
+// timeType is the reflect.Type of time.Time, which is handled specifically
+var timeType = reflect.TypeOf(time.Time{})
+
+// These are the layouts of the ADEXP time-related values
+const (
+	dateLayout        = "060102"       // date
+	timeLayout        = "1504"         // timehhmm
+	datetimeLayout    = "0601021504"   // datetime
+	datetimeSecLayout = "060102150405" // datetime with seconds, as in ETO
+)
+
+// timeLayouts indexes the layouts by the length of the values, a date being YYMMDD or CCYYMMDD
+var timeLayouts = map[int]string{
+	len(timeLayout):        timeLayout,
+	len(dateLayout):        dateLayout,
+	len("20060102"):        dateLayout,
+	len(datetimeLayout):    datetimeLayout,
+	len(datetimeSecLayout): datetimeSecLayout,
+}
+
+// timeOptions indexes the layouts by their tag option
+var timeOptions = map[string]string{
+	"date":     dateLayout,
+	"time":     timeLayout,
+	"datetime": datetimeLayout,
+	"seconds":  datetimeSecLayout,
+}
+
+// ParseTime parses an ADEXP date (YYMMDD or CCYYMMDD), time (HHMM) or datetime (YYMMDDHHMM[SS]), as UTC.
+//
+// It agrees with the typed getters such as GetTime and GetDateTime: a time is on January 1st of year 0, and 2400 is the midnight of the following day.
+func ParseTime(str string) (time.Time, error) {
+	t, err := parseTime(str, timeLayouts[len(str)])
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "ParseTime")
+	}
+	return t, nil
+}
+
+// parseTime parses a date, time or datetime as told by the layout, through date and clock.
+// The layout only tells the kind of the value: a date may be CCYYMMDD, and a time HHMMSS. An empty layout is an invalid value.
+func parseTime(str string, layout string) (time.Time, error) {
+	switch layout {
+	case dateLayout:
+		return date(str)
+	case timeLayout:
+		d, err := clock(str)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d), nil
+	case datetimeLayout, datetimeSecLayout:
+		if len(str) < len(dateLayout) {
+			return time.Time{}, errors.Errorf("%q is neither YYMMDDHHMM nor YYMMDDHHMMSS", str)
+		}
+		day, err := date(str[:len(dateLayout)])
+		if err != nil {
+			return time.Time{}, err
+		}
+		d, err := clock(str[len(dateLayout):])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return day.Add(d), nil
+	default:
+		return time.Time{}, errors.Errorf("%q is not a valid ADEXP date, time or datetime", str)
+	}
+}
+
+// isEmptyValue returns true if the value is considered empty for the omitempty option
+func isEmptyValue(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() == 0
+	case reflect.Bool:
+		return !rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() == 0
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	case reflect.Struct:
+		return reflect.DeepEqual(rv.Interface(), reflect.Zero(rv.Type()).Interface())
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package adexp
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A RoutePoint is a point of the route of a flight, as in the PT and AD elements of the RTEPTS list
+type RoutePoint struct {
+	ID        string       // ID is the PTID of a point, or the ADID of an aerodrome
+	Aerodrome bool         // Aerodrome is true for the AD elements
+	Position  *Position    // Position is nil if the point isn't defined in the message, see ADEXP.PointPosition
+	FL        *FlightLevel // FL is nil if there is no flight level
+	ETO       time.Time    // ETO is the estimated time over the point, zero if there is none
+}
+
+// GetRoutePoints returns the points of the RTEPTS list field, in order.
+//
+// The positions of the points defined in the message by a GEO or REF field are resolved, the others are left nil.
+// As a message only holds one GEO and one REF field, use Document.GetRoutePoints to resolve several points.
+// The variation is the magnetic variation used for the points defined by a REF field, see RefPoint.From.
+// The vectors (VEC) are ignored.
+func (msg ADEXP) GetRoutePoints(variation float64) ([]RoutePoint, error) {
+	return msg.Document().GetRoutePoints(variation)
+}
+
+// GetRoutePoints returns the points of the first RTEPTS list field, in order, their positions being resolved against every GEO and REF field.
+// See ADEXP.GetRoutePoints.
+func (doc Document) GetRoutePoints(variation float64) ([]RoutePoint, error) {
+	f, ok := doc.First("RTEPTS")
+	if !ok {
+		return nil, errors.Wrap(ErrFieldNotFound, "GetRoutePoints: no list field RTEPTS")
+	}
+	rtepts, ok := f.List()
+	if !ok {
+		return nil, errors.Wrapf(ErrFieldNotFound, "GetRoutePoints: RTEPTS is a %s, not a list field", f.Kind())
+	}
+
+	points := make([]RoutePoint, 0, len(rtepts.items))
+	for i, e := range rtepts.items {
+		if e.keyword != "PT" && e.keyword != "AD" {
+			continue
+		}
+		mul, ok := e.value.value.(Multi)
+		if !ok || e.kind != Structured {
+			return nil, errors.Errorf("GetRoutePoints: element #%d (%s) is a %s, expected a %s", i, e.keyword, e.kind, Structured)
+		}
+		rp, err := mul.GetRoutePoint()
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetRoutePoints: element #%d (%s)", i, e.keyword)
+		}
+		if !rp.Aerodrome {
+			if p, err := doc.PointPosition(rp.ID, variation); err == nil {
+				rp.Position = &p
+			}
+		}
+		points = append(points, rp)
+	}
+	return points, nil
+}
+
+// GetRoutePoint returns the route point held by a PT or AD structured field.
+// The position isn't resolved, as it is defined elsewhere in the message.
+func (mul *Multi) GetRoutePoint() (RoutePoint, error) {
+	var (
+		rp  RoutePoint
+		err error
+	)
+	if rp.ID, err = getPrimary(mul, "PTID"); err != nil {
+		if rp.ID, err = getPrimary(mul, "ADID"); err != nil {
+			return RoutePoint{}, errors.Wrap(err, "GetRoutePoint: neither PTID nor ADID")
+		}
+		rp.Aerodrome = true
+	}
+
+	if _, ok := mul.GetPrimary("FL"); ok {
+		fl, err := mul.GetFlightLevel("FL")
+		if err != nil {
+			return RoutePoint{}, errors.Wrapf(err, "GetRoutePoint: point %s", rp.ID)
+		}
+		rp.FL = &fl
+	}
+
+	if eto, ok := mul.GetPrimary("ETO"); ok {
+		if len(eto) != len(datetimeLayout) && len(eto) != len(datetimeSecLayout) {
+			return RoutePoint{}, errors.Errorf("GetRoutePoint: point %s: ETO %q is neither YYMMDDHHMM nor YYMMDDHHMMSS", rp.ID, eto)
+		}
+		if rp.ETO, err = ParseTime(eto); err != nil {
+			return RoutePoint{}, errors.Wrapf(err, "GetRoutePoint: point %s", rp.ID)
+		}
+	}
+
+	return rp, nil
+}
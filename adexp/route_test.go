@@ -0,0 +1,84 @@
+package adexp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestADEXP_GetRoutePoints(t *testing.T) {
+	const text = "-TITLE IFPL -GEO -GEOID GEO01 -LATTD 520000N -LONGTD 0150000W " +
+		"-BEGIN RTEPTS -AD -ADID LFPG -ETO 1401100900 " +
+		"-PT -PTID XETBO -FL F350 -ETO 140110093000 " +
+		"-VEC -FL F350 -ETO 140110093500 -RELDIST 10 " +
+		"-PT -PTID GEO01 -FL F370 -ETO 140110094000 " +
+		"-PT -PTID BUBLI " +
+		"-END RTEPTS"
+	msg := ADEXP{}
+	if err := NewDecoder(strings.NewReader(text)).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	points, err := msg.GetRoutePoints(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 4 {
+		t.Fatalf("expected 4 points, got %d: %v", len(points), points)
+	}
+
+	if ad := points[0]; ad.ID != "LFPG" || !ad.Aerodrome || ad.FL != nil || ad.ETO.Hour() != 9 {
+		t.Errorf("unexpected aerodrome %+v", ad)
+	}
+	if pt := points[1]; pt.ID != "XETBO" || pt.Position != nil || pt.FL == nil || *pt.FL != (FlightLevel{FlightLevelFeet, 350}) ||
+		!pt.ETO.Equal(time.Date(2014, 1, 10, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first point %+v", pt)
+	}
+	if pt := points[2]; pt.ID != "GEO01" || pt.Position == nil || *pt.Position != (Position{52, -15}) {
+		t.Errorf("unexpected geographical point %+v", pt)
+	}
+	if pt := points[3]; pt.ID != "BUBLI" || pt.FL != nil || !pt.ETO.IsZero() {
+		t.Errorf("unexpected last point %+v", pt)
+	}
+}
+
+func TestADEXP_GetRoutePoints_Errors(t *testing.T) {
+	msg := ADEXP{}
+	if err := NewDecoder(strings.NewReader("-TITLE IFPL -ARCID AFR456")).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	if _, err := msg.GetRoutePoints(0); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound without RTEPTS, got %v", err)
+	}
+
+	msg = ADEXP{}
+	if err := NewDecoder(strings.NewReader("-TITLE IFPL -BEGIN RTEPTS -PT -PTID XETBO -ETO 1401100930000 -END RTEPTS")).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	if _, err := msg.GetRoutePoints(0); err == nil {
+		t.Errorf("expected an error for an invalid ETO")
+	}
+}
+
+func TestDocument_GetRoutePoints(t *testing.T) {
+	var doc Document
+	err := doc.UnmarshalText([]byte("-TITLE IFPL -GEO -GEOID GEO01 -LATTD 520000N -LONGTD 0150000W -GEO -GEOID GEO02 -LATTD 510000N -LONGTD 0140000W " +
+		"-BEGIN RTEPTS -PT -PTID GEO01 -PT -PTID GEO02 -PT -PTID XETBO -END RTEPTS"))
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	points, err := doc.GetRoutePoints(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 3 {
+		t.Fatalf("expected 3 points, got %d", len(points))
+	}
+	for i, expected := range []*Position{{52, -15}, {51, -14}, nil} {
+		if p := points[i].Position; (p == nil) != (expected == nil) || (p != nil && *p != *expected) {
+			t.Errorf("%s: got position %v, expected %v", points[i].ID, p, expected)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package adexp
+
+import (
+	"github.com/aabizri/aero/adexp/lexer"
+	"github.com/aabizri/aero/adexp/parser"
+	"github.com/pkg/errors"
+)
+
+// New returns a message with the given TITLE, to be filled with SetPrimary, AddStructured & AppendListItem
+func New(title string) (ADEXP, error) {
+	msg := ADEXP{}
+	if err := msg.SetPrimary(parser.TITLEKeyword, title); err != nil {
+		return nil, errors.Wrap(err, "New")
+	}
+	return msg, nil
+}
+
+// NewStructured returns an empty structured field, whose subfields keep the order in which they are set
+func NewStructured() *Multi {
+	return &Multi{m: make(map[string]value), items: []entry{}, kind: Structured}
+}
+
+// NewList returns an empty list field
+func NewList() *Multi {
+	return &Multi{m: make(map[string]value), items: []entry{}, kind: List}
+}
+
+// SetPrimary associates a primary field with the key, replacing any previous value.
+//
+// It returns an error if the keyword or the value can't be written in an ADEXP message.
+func (msg ADEXP) SetPrimary(key string, val string) error {
+	if err := lexer.CheckKeyword(key); err != nil {
+		return errors.Wrap(err, "SetPrimary")
+	}
+	if err := lexer.CheckValue(val); err != nil {
+		return errors.Wrapf(err, "SetPrimary: invalid value for %s", key)
+	}
+	msg[key] = value{kind: Primary, value: val}
+	return nil
+}
+
+// AddStructured associates the structured field sub with the key, replacing any previous value.
+//
+// The message holds the field as it is now: later changes to sub don't alter the message.
+func (msg ADEXP) AddStructured(key string, sub *Multi) error {
+	val, err := multiValue(key, sub, Structured)
+	if err != nil {
+		return errors.Wrap(err, "AddStructured")
+	}
+	msg[key] = val
+	return nil
+}
+
+// AddList associates the list field list with the key, replacing any previous value.
+//
+// The message holds the field as it is now: later changes to list don't alter the message.
+func (msg ADEXP) AddList(key string, list *Multi) error {
+	val, err := multiValue(key, list, List)
+	if err != nil {
+		return errors.Wrap(err, "AddList")
+	}
+	msg[key] = val
+	return nil
+}
+
+// AppendListItem appends an element to the list field associated with the key, which is created if absent.
+//
+// The element is either a string for a primary field, or a *Multi for a structured or list field.
+func (msg ADEXP) AppendListItem(key string, keyword string, item interface{}) error {
+	if err := lexer.CheckKeyword(key); err != nil {
+		return errors.Wrap(err, "AppendListItem")
+	}
+	list, err := listOf(msg[key], key)
+	if err != nil {
+		return errors.Wrap(err, "AppendListItem")
+	}
+	if err := list.Append(keyword, item); err != nil {
+		return errors.Wrapf(err, "AppendListItem: list %s", key)
+	}
+	msg[key] = value{kind: List, value: *list}
+	return nil
+}
+
+// Delete removes the field associated with the key, if any
+func (msg ADEXP) Delete(key string) {
+	delete(msg, key)
+}
+
+// SetPrimary associates a primary subfield with the key in a structured field, replacing any previous value.
+func (mul *Multi) SetPrimary(key string, val string) error {
+	if err := mul.checkKind(Structured); err != nil {
+		return errors.Wrap(err, "SetPrimary")
+	}
+	if err := lexer.CheckKeyword(key); err != nil {
+		return errors.Wrap(err, "SetPrimary")
+	}
+	if err := lexer.CheckValue(val); err != nil {
+		return errors.Wrapf(err, "SetPrimary: invalid value for %s", key)
+	}
+	mul.set(key, value{kind: Primary, value: val})
+	return nil
+}
+
+// AddStructured associates the structured subfield sub with the key in a structured field, replacing any previous value.
+// See ADEXP.AddStructured.
+func (mul *Multi) AddStructured(key string, sub *Multi) error {
+	if err := mul.checkKind(Structured); err != nil {
+		return errors.Wrap(err, "AddStructured")
+	}
+	val, err := multiValue(key, sub, Structured)
+	if err != nil {
+		return errors.Wrap(err, "AddStructured")
+	}
+	mul.set(key, val)
+	return nil
+}
+
+// AddList associates the list subfield list with the key in a structured field, replacing any previous value.
+// See ADEXP.AddList.
+func (mul *Multi) AddList(key string, list *Multi) error {
+	if err := mul.checkKind(Structured); err != nil {
+		return errors.Wrap(err, "AddList")
+	}
+	val, err := multiValue(key, list, List)
+	if err != nil {
+		return errors.Wrap(err, "AddList")
+	}
+	mul.set(key, val)
+	return nil
+}
+
+// AppendListItem appends an element to the list subfield associated with the key in a structured field, which is created if absent.
+// See ADEXP.AppendListItem.
+func (mul *Multi) AppendListItem(key string, keyword string, item interface{}) error {
+	if err := mul.checkKind(Structured); err != nil {
+		return errors.Wrap(err, "AppendListItem")
+	}
+	if err := lexer.CheckKeyword(key); err != nil {
+		return errors.Wrap(err, "AppendListItem")
+	}
+	list, err := listOf(mul.m[key], key)
+	if err != nil {
+		return errors.Wrap(err, "AppendListItem")
+	}
+	if err := list.Append(keyword, item); err != nil {
+		return errors.Wrapf(err, "AppendListItem: list %s", key)
+	}
+	mul.set(key, value{kind: List, value: *list})
+	return nil
+}
+
+// Append appends an element to a list field.
+//
+// The element is either a string for a primary field, or a *Multi for a structured or list field, held as it is now.
+func (mul *Multi) Append(keyword string, item interface{}) error {
+	if err := mul.checkKind(List); err != nil {
+		return errors.Wrap(err, "Append")
+	}
+	if err := lexer.CheckKeyword(keyword); err != nil {
+		return errors.Wrap(err, "Append")
+	}
+
+	var val value
+	switch item := item.(type) {
+	case string:
+		if err := lexer.CheckValue(item); err != nil {
+			return errors.Wrapf(err, "Append: invalid value for %s", keyword)
+		}
+		val = value{kind: Primary, value: item}
+	case *Multi:
+		if item == nil || (item.kind != Structured && item.kind != List) {
+			return errors.Errorf("Append: element %s is neither a structured nor a list field", keyword)
+		}
+		item.shared = true
+		val = value{kind: item.kind, value: *item}
+	default:
+		return errors.Errorf("Append: unsupported element of type %T, expected a string or a *Multi", item)
+	}
+
+	mul.own()
+	mul.items = append(mul.items, entry{keyword: keyword, value: val})
+
+	// Only the first element with a given keyword is directly accessible
+	if _, ok := mul.m[keyword]; !ok {
+		mul.m[keyword] = val
+	}
+	return nil
+}
+
+// Delete removes the subfield associated with the key in a structured field, or every element with the keyword in a list field
+func (mul *Multi) Delete(key string) {
+	mul.own()
+	delete(mul.m, key)
+	if mul.items == nil {
+		return
+	}
+	items := make([]entry, 0, len(mul.items))
+	for _, e := range mul.items {
+		if e.keyword != key {
+			items = append(items, e)
+		}
+	}
+	mul.items = items
+}
+
+// checkKind checks that the Multi was made, and is of the given kind
+func (mul *Multi) checkKind(kind Kind) error {
+	switch {
+	case mul.m == nil:
+		return errors.New("uninitialised field, use NewStructured or NewList")
+	case mul.kind != kind:
+		return errors.Errorf("not available on a %s", mul.kind)
+	}
+	return nil
+}
+
+// set associates the value with the key in a structured field, keeping the order of its subfields if known
+func (mul *Multi) set(key string, val value) {
+	mul.own()
+	_, replaced := mul.m[key]
+	mul.m[key] = val
+	if mul.items == nil {
+		return
+	}
+	if replaced {
+		for i := range mul.items {
+			if mul.items[i].keyword == key {
+				mul.items[i].value = val
+				return
+			}
+		}
+	}
+	mul.items = append(mul.items, entry{keyword: key, value: val})
+}
+
+// own copies the subfields of the Multi if they are shared with a stored field, so that they can be modified
+func (mul *Multi) own() {
+	if !mul.shared {
+		return
+	}
+	m := make(map[string]value, len(mul.m))
+	for k, v := range mul.m {
+		m[k] = v
+	}
+	mul.m = m
+	if mul.items != nil {
+		items := make([]entry, len(mul.items))
+		copy(items, mul.items)
+		mul.items = items
+	}
+	mul.shared = false
+}
+
+// multiValue returns the value of a structured or list field to be associated with the key.
+// mul then shares its subfields with the value, and copies them before modifying them.
+func multiValue(key string, mul *Multi, kind Kind) (value, error) {
+	if err := lexer.CheckKeyword(key); err != nil {
+		return value{}, err
+	}
+	if mul == nil || mul.kind != kind {
+		return value{}, errors.Errorf("field %s isn't a %s", key, kind)
+	}
+	mul.shared = true
+	return value{kind: kind, value: *mul}, nil
+}
+
+// listOf returns a detached copy of the list field val, or a new one if there is none
+func listOf(val value, key string) (*Multi, error) {
+	switch val.kind {
+	case Err:
+		return NewList(), nil
+	case List:
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return nil, errors.Errorf("kind %s but value of type %T", val.kind, val.value)
+		}
+		return detached(mul), nil
+	default:
+		return nil, errors.Errorf("field %s is a %s, not a list field", key, val.kind)
+	}
+}
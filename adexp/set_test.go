@@ -0,0 +1,168 @@
+package adexp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestADEXP_SetPrimary(t *testing.T) {
+	msg := ADEXP{}
+	if err := msg.SetPrimary("ARCID", "AFR456"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if arcid, ok := msg.GetPrimary("ARCID"); !ok || arcid != "AFR456" {
+		t.Errorf("unexpected ARCID: got (%q, %t)", arcid, ok)
+	}
+	if err := msg.SetPrimary("RMK", "TCAS (X) A/C +1?:.,'="); err != nil {
+		t.Errorf("unexpected error for a value with LIM_CHAR characters: %v", err)
+	}
+
+	for _, kv := range [][2]string{{"arcid", "AFR456"}, {"", "AFR456"}, {"ARCID", ""}, {"ARCID", "AFR-456"}, {"ARCID", "afr456"}, {"RMK", "A_B"}} {
+		if err := msg.SetPrimary(kv[0], kv[1]); err == nil {
+			t.Errorf("SetPrimary(%q, %q): expected an error", kv[0], kv[1])
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	msg, err := New("SAM")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := msg.SetPrimary("ARCID", "AFR456"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, fac := range []string{"LFPGZQZX", "EGLLZQZX"} {
+		if err := msg.AppendListItem("ADDR", "FAC", fac); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	pt := NewStructured()
+	if err := pt.SetPrimary("PTID", "XETBO"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := pt.SetPrimary("ETO", "140110093000"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := msg.AppendListItem("RTEPTS", "PT", pt); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	geo := NewStructured()
+	for _, kv := range [][2]string{{"GEOID", "01"}, {"LATTD", "520000N"}, {"LONGTD", "0150000W"}} {
+		if err := geo.SetPrimary(kv[0], kv[1]); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := msg.AddStructured("GEO", geo); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := msg.SetPrimary("ADEP", "LFPG"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	msg.Delete("ADEP")
+
+	text, err := msg.MarshalText()
+	if err != nil {
+		t.Fatalf("error while encoding: %v", err)
+	}
+	const expected = "-TITLE SAM\n-BEGIN ADDR\n\t-FAC LFPGZQZX\n\t-FAC EGLLZQZX\n-END ADDR\n-ARCID AFR456\n" +
+		"-GEO\n\t-GEOID 01\n\t-LATTD 520000N\n\t-LONGTD 0150000W\n-BEGIN RTEPTS\n\t-PT\n\t\t-PTID XETBO\n\t\t-ETO 140110093000\n-END RTEPTS\n"
+	if string(text) != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", text, expected)
+	}
+
+	if _, err := New("sam"); err == nil {
+		t.Errorf("expected an error for an invalid TITLE")
+	}
+	if err := msg.AppendListItem("ARCID", "FAC", "LFPGZQZX"); err == nil {
+		t.Errorf("expected an error when appending to a primary field")
+	}
+	if err := msg.AppendListItem("ADDR", "FAC", 42); err == nil {
+		t.Errorf("expected an error when appending an int")
+	}
+	if err := msg.AddStructured("GEO", NewList()); err == nil {
+		t.Errorf("expected an error when adding a list as a structured field")
+	}
+	if err := (&Multi{}).SetPrimary("GEOID", "01"); err == nil {
+		t.Errorf("expected an error on an uninitialised Multi")
+	}
+	if err := NewList().SetPrimary("GEOID", "01"); err == nil {
+		t.Errorf("expected an error when setting a subfield of a list")
+	}
+}
+
+func TestMulti_Delete(t *testing.T) {
+	list := NewList()
+	for _, kv := range [][2]string{{"FAC", "LFPGZQZX"}, {"AD", "LFPG"}, {"FAC", "EGLLZQZX"}} {
+		if err := list.Append(kv[0], kv[1]); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	list.Delete("FAC")
+	if len(list.items) != 1 || list.items[0].keyword != "AD" {
+		t.Errorf("unexpected elements after deletion: %v", list.items)
+	}
+	if _, ok := list.GetPrimary("FAC"); ok {
+		t.Errorf("FAC still accessible after deletion")
+	}
+
+	geo := NewStructured()
+	geo.SetPrimary("GEOID", "01")
+	geo.SetPrimary("LATTD", "520000N")
+	geo.Delete("GEOID")
+	if _, ok := geo.GetPrimary("GEOID"); ok || len(geo.items) != 1 {
+		t.Errorf("GEOID still present after deletion")
+	}
+}
+
+func TestADEXP_AddList(t *testing.T) {
+	msg := ADEXP{}
+	if err := msg.UnmarshalText([]byte("-TITLE IFPL -GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W -BEGIN ADDR -FAC LFPGZQZX -FAC EGLLZQZX -END ADDR")); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	// Editing a fetched field doesn't alter the message
+	addr, _ := msg.GetList("ADDR")
+	if err := addr.Append("AFTN", "X"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	addr.Delete("FAC")
+	geo, _ := msg.GetStructured("GEO")
+	if err := geo.SetPrimary("GEOID", "02"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stored, _ := msg.GetList("ADDR")
+	if _, ok := stored.GetPrimary("AFTN"); ok || strings.Join(stored.Keys(), " ") != "FAC FAC" {
+		t.Errorf("unexpected ADDR in the message: %v", stored.Keys())
+	}
+	if _, ok := stored.GetPrimary("FAC"); !ok {
+		t.Errorf("FAC not found in the message")
+	}
+	if _, err := msg.Lookup("ADDR/FAC[1]"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	f, _ := msg.Lookup("GEO/GEOID")
+	if id, _ := f.Primary(); id != "01" {
+		t.Errorf("unexpected GEOID %q in the message", id)
+	}
+
+	// Until it is stored back
+	if err := msg.AddList("ADDR", addr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := msg.AddStructured("GEO", geo); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	addr.Delete("AFTN")
+	text, err := msg.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if str := string(text); !strings.Contains(str, "-BEGIN ADDR\n\t-AFTN X\n-END ADDR") || strings.Contains(str, "FAC") || !strings.Contains(str, "-GEOID 02") {
+		t.Errorf("unexpected message:\n%s", str)
+	}
+
+	if err := msg.AddList("ADDR", geo); err == nil {
+		t.Errorf("expected an error when adding a structured field as a list")
+	}
+}
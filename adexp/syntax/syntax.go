@@ -0,0 +1,301 @@
+/*
+Package syntax compiles the formal syntax definitions of the ADEXP v3.1 specification into value checkers.
+
+The definitions use the notation of the specification:
+
+	'X' or "X"	a literal
+	a ! b		a immediately followed by b
+	a b		a followed by b, possibly separated by separators
+	a | b		either a or b
+	[ a ]		an optional a
+	( a )		a group
+	n{ a }m		a repeated between n and m times, n defaults to 0 and m to infinity
+	ALPHA, DIGIT, ALPHANUM, LIM_CHAR, CHARACTER	the character classes of the specification
+
+Any other name is an auxiliary term, whose definition is obtained through a Resolver.
+*/
+package syntax
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// maxDepth is the maximum depth of auxiliary term resolution, so as to detect cycles
+const maxDepth = 16
+
+// classes are the character classes of the specification, as regular expressions
+var classes = map[string]string{
+	"ALPHA":     `[A-Z]`,
+	"DIGIT":     `[0-9]`,
+	"ALPHANUM":  `[A-Z0-9]`,
+	"LIM_CHAR":  `[A-Z0-9 ()?:.,'=+/]`,
+	"CHARACTER": `[A-Z0-9 ()?:.,'=+/\-]`,
+}
+
+// A Resolver returns the definition of an auxiliary term
+type Resolver func(name string) (def string, ok bool)
+
+// A Matcher checks values against a syntax definition
+type Matcher struct {
+	def string
+	re  *regexp.Regexp
+}
+
+// Compile compiles a syntax definition, using resolve to obtain the definitions of the auxiliary terms.
+func Compile(def string, resolve Resolver) (*Matcher, error) {
+	pattern, err := translate(def, resolve, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Compile: error while translating %q", def)
+	}
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Compile: error while compiling %q", def)
+	}
+	return &Matcher{def: def, re: re}, nil
+}
+
+// Match returns true if the value conforms to the syntax
+func (m *Matcher) Match(value string) bool {
+	return m.re.MatchString(value)
+}
+
+// String returns the syntax definition
+func (m *Matcher) String() string {
+	return m.def
+}
+
+// translate translates a definition to a regular expression
+func translate(def string, resolve Resolver, depth int) (string, error) {
+	if depth > maxDepth {
+		return "", errors.New("too many levels of auxiliary terms, there is probably a cycle")
+	}
+	tokens, err := tokenize(def)
+	if err != nil {
+		return "", err
+	}
+	p := &translator{tokens: tokens, resolve: resolve, depth: depth}
+	pattern, err := p.alternation()
+	if err != nil {
+		return "", err
+	}
+	if p.pos != len(p.tokens) {
+		return "", errors.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	return pattern, nil
+}
+
+// A tokenKind is the kind of a token in a syntax definition
+type tokenKind uint8
+
+const (
+	tokenLiteral tokenKind = iota
+	tokenNumber
+	tokenName
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize splits a definition in tokens
+func tokenize(def string) ([]token, error) {
+	var tokens []token
+	runes := []rune(def)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '\'' || r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, errors.Errorf("unterminated literal at offset %d", i)
+			}
+			tokens = append(tokens, token{tokenLiteral, strings.TrimSpace(string(runes[i+1 : end]))})
+			i = end + 1
+
+		case unicode.IsDigit(r):
+			end := i
+			for end < len(runes) && unicode.IsDigit(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, token{tokenNumber, string(runes[i:end])})
+			i = end
+
+		case unicode.IsLetter(r) || r == '_':
+			end := i
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+				end++
+			}
+			tokens = append(tokens, token{tokenName, string(runes[i:end])})
+			i = end
+
+		case strings.ContainsRune("{}[]()|!", r):
+			tokens = append(tokens, token{tokenSymbol, string(r)})
+			i++
+
+		default:
+			return nil, errors.Errorf("unexpected character %q at offset %d", r, i)
+		}
+	}
+	return tokens, nil
+}
+
+// translator is a recursive-descent translator of a tokenized definition
+type translator struct {
+	tokens  []token
+	pos     int
+	resolve Resolver
+	depth   int
+}
+
+// peek returns the current token, ok is false at the end
+func (p *translator) peek() (t token, ok bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// isSymbol returns true if the current token is one of the given symbols
+func (p *translator) isSymbol(symbols string) bool {
+	t, ok := p.peek()
+	return ok && t.kind == tokenSymbol && strings.Contains(symbols, t.text)
+}
+
+// alternation := sequence { '|' sequence }
+func (p *translator) alternation() (string, error) {
+	var alternatives []string
+	for {
+		s, err := p.sequence()
+		if err != nil {
+			return "", err
+		}
+		alternatives = append(alternatives, s)
+		if !p.isSymbol("|") {
+			break
+		}
+		p.pos++
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return `(?:` + strings.Join(alternatives, `|`) + `)`, nil
+}
+
+// sequence := repetition { ['!'] repetition }
+func (p *translator) sequence() (string, error) {
+	var pattern string
+	for i := 0; ; i++ {
+		if _, ok := p.peek(); !ok || p.isSymbol("|)]}") {
+			if i == 0 {
+				return "", errors.New("empty sequence")
+			}
+			return pattern, nil
+		}
+
+		// Elements are separated by separators unless joined by a '!'
+		// A leading '!', as in [! "/" ! x], joins the sequence to what precedes it: the separators are then tolerated
+		if p.isSymbol("!") {
+			p.pos++
+		} else if i != 0 {
+			pattern += ` *`
+		}
+
+		s, err := p.repetition()
+		if err != nil {
+			return "", err
+		}
+		pattern += s
+	}
+}
+
+// repetition := [number] '{' alternation '}' [number] | element
+func (p *translator) repetition() (string, error) {
+	min, max := "0", ""
+	if t, _ := p.peek(); t.kind == tokenNumber && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "{" {
+		min = t.text
+		p.pos++
+	}
+	if !p.isSymbol("{") {
+		return p.element()
+	}
+	p.pos++
+
+	inner, err := p.alternation()
+	if err != nil {
+		return "", err
+	}
+	// The specification sometimes closes braces with a parenthesis
+	if !p.isSymbol("})") {
+		return "", errors.New("expected '}'")
+	}
+	p.pos++
+
+	// A number followed by a brace is the minimum of the next repetition
+	if t, ok := p.peek(); ok && t.kind == tokenNumber && !(p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text == "{") {
+		max = t.text
+		p.pos++
+	}
+	return `(?:` + inner + `){` + min + `,` + max + `}`, nil
+}
+
+// element := literal | name | '(' alternation ')' | '[' alternation ']'
+func (p *translator) element() (string, error) {
+	t, ok := p.peek()
+	if !ok {
+		return "", errors.New("unexpected end of definition")
+	}
+	p.pos++
+
+	switch t.kind {
+	case tokenLiteral, tokenNumber:
+		return regexp.QuoteMeta(t.text), nil
+
+	case tokenName:
+		if class, ok := classes[t.text]; ok {
+			return class, nil
+		}
+		def, ok := p.resolve(t.text)
+		if !ok {
+			return "", errors.Errorf("unknown auxiliary term %q", t.text)
+		}
+		pattern, err := translate(def, p.resolve, p.depth+1)
+		if err != nil {
+			return "", errors.Wrapf(err, "in auxiliary term %q", t.text)
+		}
+		return `(?:` + pattern + `)`, nil
+
+	case tokenSymbol:
+		var closing, suffix string
+		switch t.text {
+		case "(":
+			closing = ")"
+		case "[":
+			closing, suffix = "]", "?"
+		default:
+			return "", errors.Errorf("unexpected %q", t.text)
+		}
+		inner, err := p.alternation()
+		if err != nil {
+			return "", err
+		}
+		if !p.isSymbol(closing) {
+			return "", errors.Errorf("expected %q", closing)
+		}
+		p.pos++
+		return `(?:` + inner + `)` + suffix, nil
+	}
+
+	return "", errors.Errorf("unexpected %q", t.text)
+}
@@ -0,0 +1,68 @@
+package syntax
+
+import "testing"
+
+// terms are a few auxiliary terms of the specification
+var terms = map[string]string{
+	"aircraftid":    "2{ ALPHANUM }7",
+	"icaoaerodrome": "4{ ALPHA }4",
+	"flightlevel":   "('F' ! 3{DIGIT}3) | ('A' ! 3{DIGIT}3) | ('S' ! 4{DIGIT}4) | ('M' ! 4{DIGIT}4)",
+	"cycle":         "cycle",
+}
+
+func resolve(name string) (string, bool) {
+	def, ok := terms[name]
+	return def, ok
+}
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		def   string
+		value string
+		match bool
+	}{
+		{"aircraftid", "AFR456", true},
+		{"aircraftid", "A", false},
+		{"aircraftid", "AFR4567890", false},
+		{"icaoaerodrome", "LFPG", true},
+		{"icaoaerodrome", "12345", false},
+		{"flightlevel", "F350", true},
+		{"flightlevel", "S1130", true},
+		{"flightlevel", "F35", false},
+		{"flightlevel", "F 350", false},
+		{"icaoaerodrome [flightlevel]", "LFPG", true},
+		{"icaoaerodrome [flightlevel]", "LFPG F350", true},
+		{"icaoaerodrome ! '/' ! 1{DIGIT}", "LFPG/12", true},
+		{"icaoaerodrome ! '/' ! 1{DIGIT}", "LFPG / 12", false},
+		{"1{ LIM_CHAR }", "DCT (ABC) 12.5/3?", true},
+		{"1{ LIM_CHAR }", "DCT-ABC", false},
+		{"'NIL' | 6{DIGIT}6", "NIL", true},
+		{"'NIL' | 6{DIGIT}6", "140110", true},
+	}
+
+	for _, test := range tests {
+		m, err := Compile(test.def, resolve)
+		if err != nil {
+			t.Errorf("Compile(%q): unexpected error: %v", test.def, err)
+			continue
+		}
+		if got := m.Match(test.value); got != test.match {
+			t.Errorf("%q.Match(%q): expected %t, got %t", test.def, test.value, test.match, got)
+		}
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	for _, def := range []string{
+		"",
+		"'unterminated",
+		"(aircraftid",
+		"unknownterm",
+		"cycle",
+		"aircraftid }",
+	} {
+		if _, err := Compile(def, resolve); err == nil {
+			t.Errorf("Compile(%q): expected an error", def)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package adexp
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrFieldNotFound is the cause of the errors returned by the typed getters when the field is missing or isn't a primary field
+var ErrFieldNotFound = errors.New("field not found")
+
+// ErrInconsistentValue is the cause of the errors returned when a field holds a value which doesn't match its kind,
+// which the getters returning a boolean can't tell from a missing field
+var ErrInconsistentValue = errors.New("value inconsistent with its kind")
+
+// primaryGetter is implemented by ADEXP and *Multi
+type primaryGetter interface {
+	get(key string) (value, bool)
+}
+
+// getPrimary returns the primary field associated with the key, or an error caused by ErrFieldNotFound or ErrInconsistentValue
+func getPrimary(pg primaryGetter, key string) (string, error) {
+	v, ok := pg.get(key)
+	if !ok || v.kind != Primary {
+		return "", errors.Wrapf(ErrFieldNotFound, "no primary field %s", key)
+	}
+	str, ok := v.value.(string)
+	if !ok {
+		return "", errors.Wrapf(ErrInconsistentValue, "primary field %s holds a %T", key, v.value)
+	}
+	return str, nil
+}
+
+// clock parses a time of day, as HHMM or HHMMSS, into the duration since midnight.
+// 2400 is accepted as the end of the day.
+func clock(str string) (time.Duration, error) {
+	if len(str) != 4 && len(str) != 6 {
+		return 0, errors.Errorf("%q is neither HHMM nor HHMMSS", str)
+	}
+	var parts [3]int
+	for i := 0; i < len(str)/2; i++ {
+		n, err := parseDigits(str[2*i:2*i+2], 2)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid time %q", str)
+		}
+		parts[i] = n
+	}
+	hh, mm, ss := parts[0], parts[1], parts[2]
+	if mm > 59 || ss > 59 || hh > 24 || (hh == 24 && (mm != 0 || ss != 0)) {
+		return 0, errors.Errorf("time %q out of range", str)
+	}
+	return time.Duration(hh)*time.Hour + time.Duration(mm)*time.Minute + time.Duration(ss)*time.Second, nil
+}
+
+// date parses a date, as YYMMDD or CCYYMMDD, in UTC
+func date(str string) (time.Time, error) {
+	layout := dateLayout
+	if len(str) == len("20060102") {
+		layout = "20060102"
+	} else if len(str) != len(dateLayout) {
+		return time.Time{}, errors.Errorf("%q is neither YYMMDD nor CCYYMMDD", str)
+	}
+	return time.ParseInLocation(layout, str, time.UTC)
+}
+
+func getTime(pg primaryGetter, key string) (time.Time, error) {
+	str, err := getPrimary(pg, key)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetTime")
+	}
+	t, err := parseTime(str, timeLayout)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "GetTime: invalid field %s", key)
+	}
+	return t, nil
+}
+
+func getDate(pg primaryGetter, key string) (time.Time, error) {
+	str, err := getPrimary(pg, key)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetDate")
+	}
+	t, err := date(str)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "GetDate: invalid field %s", key)
+	}
+	return t, nil
+}
+
+func getDateTime(pg primaryGetter, dateKey string, timeKey string) (time.Time, error) {
+	day, err := getDate(pg, dateKey)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetDateTime")
+	}
+	str, err := getPrimary(pg, timeKey)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetDateTime")
+	}
+	d, err := clock(str)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "GetDateTime: invalid field %s", timeKey)
+	}
+	return day.Add(d), nil
+}
+
+func getFlightLevel(pg primaryGetter, key string) (FlightLevel, error) {
+	str, err := getPrimary(pg, key)
+	if err != nil {
+		return FlightLevel{}, errors.Wrap(err, "GetFlightLevel")
+	}
+	fl, err := ParseFlightLevel(str)
+	return fl, errors.Wrapf(err, "GetFlightLevel: invalid field %s", key)
+}
+
+func getSpeed(pg primaryGetter, key string) (Speed, error) {
+	str, err := getPrimary(pg, key)
+	if err != nil {
+		return Speed{}, errors.Wrap(err, "GetSpeed")
+	}
+	spd, err := ParseSpeed(str)
+	return spd, errors.Wrapf(err, "GetSpeed: invalid field %s", key)
+}
+
+// GetTime returns the time of day held by the key, written as HHMM or HHMMSS, such as EOBT.
+//
+// The date of the returned time is January 1st of year 0, in UTC. 2400 is returned as the midnight of the following day.
+func (msg ADEXP) GetTime(key string) (time.Time, error) {
+	return getTime(msg, key)
+}
+
+// GetDate returns the date held by the key, written as YYMMDD or CCYYMMDD, such as EOBD.
+func (msg ADEXP) GetDate(key string) (time.Time, error) {
+	return getDate(msg, key)
+}
+
+// GetDateTime returns the date & time held by the two keys, such as EOBD & EOBT.
+//
+// A time of 2400 rolls over to the midnight of the following day, across the end of a month or a year too.
+// It is the only rollover: the time is always taken on the date given, even when it precedes another time of the message,
+// such as an ETO past midnight, the date of which is then found in another field.
+func (msg ADEXP) GetDateTime(dateKey string, timeKey string) (time.Time, error) {
+	return getDateTime(msg, dateKey, timeKey)
+}
+
+// GetFlightLevel returns the flight level held by the key, such as RFL
+func (msg ADEXP) GetFlightLevel(key string) (FlightLevel, error) {
+	return getFlightLevel(msg, key)
+}
+
+// GetSpeed returns the speed held by the key, such as SPEED
+func (msg ADEXP) GetSpeed(key string) (Speed, error) {
+	return getSpeed(msg, key)
+}
+
+// GetTime returns the time of day held by the key, see ADEXP.GetTime
+func (mul *Multi) GetTime(key string) (time.Time, error) {
+	return getTime(mul, key)
+}
+
+// GetDate returns the date held by the key, see ADEXP.GetDate
+func (mul *Multi) GetDate(key string) (time.Time, error) {
+	return getDate(mul, key)
+}
+
+// GetDateTime returns the date & time held by the two keys, see ADEXP.GetDateTime
+func (mul *Multi) GetDateTime(dateKey string, timeKey string) (time.Time, error) {
+	return getDateTime(mul, dateKey, timeKey)
+}
+
+// GetFlightLevel returns the flight level held by the key, such as FL in a PT
+func (mul *Multi) GetFlightLevel(key string) (FlightLevel, error) {
+	return getFlightLevel(mul, key)
+}
+
+// GetSpeed returns the speed held by the key, such as PTSPEED in a PT
+func (mul *Multi) GetSpeed(key string) (Speed, error) {
+	return getSpeed(mul, key)
+}
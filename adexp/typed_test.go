@@ -0,0 +1,209 @@
+package adexp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestADEXP_GetDateTime(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE IFPL -EOBD 140110 -EOBT 0930 -ETOT 2400 -ARRD 20140111 -BADT 2460")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	if eobt, err := msg.GetTime("EOBT"); err != nil || eobt.Hour() != 9 || eobt.Minute() != 30 {
+		t.Errorf("unexpected EOBT: %v (%v)", eobt, err)
+	}
+	if eobd, err := msg.GetDate("EOBD"); err != nil || !eobd.Equal(time.Date(2014, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected EOBD: %v (%v)", eobd, err)
+	}
+	if arrd, err := msg.GetDate("ARRD"); err != nil || !arrd.Equal(time.Date(2014, 1, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected ARRD: %v (%v)", arrd, err)
+	}
+	if dt, err := msg.GetDateTime("EOBD", "EOBT"); err != nil || !dt.Equal(time.Date(2014, 1, 10, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected EOBD/EOBT: %v (%v)", dt, err)
+	}
+	if dt, err := msg.GetDateTime("EOBD", "ETOT"); err != nil || !dt.Equal(time.Date(2014, 1, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("2400 should roll over to the next day, got %v (%v)", dt, err)
+	}
+	for _, test := range []struct{ date, expected string }{{"141231", "150101"}, {"140131", "140201"}, {"160228", "160229"}} {
+		msg := ADEXP{"EOBD": value{kind: Primary, value: test.date}, "EOBT": value{kind: Primary, value: "2400"}}
+		expected, _ := time.Parse(dateLayout, test.expected)
+		if dt, err := msg.GetDateTime("EOBD", "EOBT"); err != nil || !dt.Equal(expected) {
+			t.Errorf("%s 2400: got %v (%v), expected %v", test.date, dt, err, expected)
+		}
+	}
+
+	if _, err := msg.GetTime("BADT"); err == nil {
+		t.Errorf("expected an error for an invalid time")
+	}
+	if _, err := msg.GetDate("EOBT"); err == nil {
+		t.Errorf("expected an error for an invalid date")
+	}
+	if _, err := msg.GetTime("ATOT"); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound for a missing field, got %v", err)
+	}
+}
+
+func TestParseFlightLevel(t *testing.T) {
+	tests := []struct {
+		str    string
+		fl     FlightLevel
+		feet   float64
+		metres float64
+	}{
+		{"F350", FlightLevel{FlightLevelFeet, 350}, 35000, 10668},
+		{"A045", FlightLevel{AltitudeFeet, 45}, 4500, 1371.6},
+		{"S1130", FlightLevel{FlightLevelMetres, 1130}, 37073.49, 11300},
+		{"M0840", FlightLevel{AltitudeMetres, 840}, 27559.06, 8400},
+	}
+	for _, test := range tests {
+		fl, err := ParseFlightLevel(test.str)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.str, err)
+			continue
+		}
+		if fl != test.fl {
+			t.Errorf("%s: expected %v, got %v", test.str, test.fl, fl)
+		}
+		if fl.String() != test.str {
+			t.Errorf("%s: String returned %s", test.str, fl)
+		}
+		if d := fl.Feet() - test.feet; d > 0.01 || d < -0.01 {
+			t.Errorf("%s: expected %f feet, got %f", test.str, test.feet, fl.Feet())
+		}
+		if d := fl.Metres() - test.metres; d > 0.01 || d < -0.01 {
+			t.Errorf("%s: expected %f metres, got %f", test.str, test.metres, fl.Metres())
+		}
+	}
+
+	for _, str := range []string{"", "350", "F35", "F3500", "X350", "S113", "F3A0"} {
+		if _, err := ParseFlightLevel(str); err == nil {
+			t.Errorf("%q: expected an error", str)
+		}
+	}
+}
+
+func TestParseSpeed(t *testing.T) {
+	for _, test := range []struct {
+		str   string
+		speed Speed
+		knots float64
+	}{
+		{"N0450", Speed{Knots, 450}, 450},
+		{"K0830", Speed{KilometresPerHour, 830}, 448.16},
+		{"M082", Speed{Mach, 82}, 0},
+	} {
+		spd, err := ParseSpeed(test.str)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.str, err)
+			continue
+		}
+		if spd != test.speed || spd.String() != test.str {
+			t.Errorf("%s: unexpected speed %v", test.str, spd)
+		}
+		knots, ok := spd.Knots()
+		if d := knots - test.knots; ok != (spd.Unit != Mach) || d > 0.01 || d < -0.01 {
+			t.Errorf("%s: unexpected knots (%f, %t)", test.str, knots, ok)
+		}
+	}
+
+	if mach, ok := (Speed{Mach, 82}).Mach(); !ok || mach != 0.82 {
+		t.Errorf("unexpected Mach number (%f, %t)", mach, ok)
+	}
+	for _, str := range []string{"", "N450", "M0820", "X0450"} {
+		if _, err := ParseSpeed(str); err == nil {
+			t.Errorf("%q: expected an error", str)
+		}
+	}
+}
+
+func TestMulti_GetFlightLevel(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE IFPL -BEGIN RTEPTS -PT -PTID XETBO -FL F350 -ETO 140110093000 -END RTEPTS")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	rtepts, _ := msg.GetList("RTEPTS")
+	pt, ok := rtepts.GetStructured("PT")
+	if !ok {
+		t.Fatalf("PT not found")
+	}
+	if fl, err := pt.GetFlightLevel("FL"); err != nil || fl != (FlightLevel{FlightLevelFeet, 350}) {
+		t.Errorf("unexpected FL: %v (%v)", fl, err)
+	}
+	if _, err := pt.GetSpeed("PTSPEED"); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("expected ErrFieldNotFound for a missing field, got %v", err)
+	}
+}
+
+func TestADEXP_InconsistentValue(t *testing.T) {
+	msg := ADEXP{
+		"ETOT": value{kind: Primary, value: 42},
+		"GEO":  value{kind: Structured, value: "01"},
+	}
+	if _, ok := msg.GetPrimary("ETOT"); ok {
+		t.Errorf("GetPrimary: expected ok to be false")
+	}
+	if _, err := msg.GetTime("ETOT"); errors.Cause(err) != ErrInconsistentValue {
+		t.Errorf("GetTime: expected ErrInconsistentValue, got %v", err)
+	}
+	if _, err := msg.GetTime("EOBT"); errors.Cause(err) != ErrFieldNotFound {
+		t.Errorf("GetTime: expected ErrFieldNotFound for a missing field, got %v", err)
+	}
+	if _, err := msg.Lookup("GEO/GEOID"); errors.Cause(err) != ErrInconsistentValue {
+		t.Errorf("Lookup: expected ErrInconsistentValue, got %v", err)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	const text = "-TITLE IFPL -EOBD 20141231 -EOBT 2400 -ETOT 093015 -ETO 1412312400"
+	msg := ADEXP{}
+	if err := NewDecoder(strings.NewReader(text)).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	var v struct {
+		EOBD time.Time `adexp:",date"`
+		EOBT time.Time `adexp:",time"`
+		ETOT time.Time `adexp:",time"`
+		ETO  time.Time `adexp:",datetime"`
+	}
+	if err := Unmarshal([]byte(text), &v); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	// The getters, ParseTime and the tag options agree
+	eobd, _ := msg.GetDate("EOBD")
+	eobt, _ := msg.GetTime("EOBT")
+	etot, _ := msg.GetTime("ETOT")
+	eto, _ := msg.GetDateTime("EOBD", "EOBT")
+	for _, test := range []struct {
+		name     string
+		str      string
+		got      time.Time
+		expected time.Time
+	}{
+		{"EOBD", "20141231", v.EOBD, eobd},
+		{"EOBT", "2400", v.EOBT, eobt},
+		{"ETOT", "", v.ETOT, etot},
+		{"ETO", "1412312400", v.ETO, eto},
+	} {
+		if !test.got.Equal(test.expected) {
+			t.Errorf("%s: decoded %s, the getters give %s", test.name, test.got, test.expected)
+		}
+		if test.str == "" {
+			continue // 093015 is a date to ParseTime, the time option tells it apart
+		}
+		if parsed, err := ParseTime(test.str); err != nil || !parsed.Equal(test.expected) {
+			t.Errorf("%s: ParseTime gives %s (%v), the getters %s", test.name, parsed, err, test.expected)
+		}
+	}
+
+	if _, err := ParseTime("2460"); err == nil {
+		t.Errorf("expected an error for 2460")
+	}
+}
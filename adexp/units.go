@@ -0,0 +1,158 @@
+package adexp
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// A FlightLevelUnit indicates how a FlightLevel is expressed
+type FlightLevelUnit byte
+
+// These are the units of flight levels defined by the specification
+const (
+	FlightLevelFeet   FlightLevelUnit = 'F' // Flight level, in hundreds of feet
+	AltitudeFeet      FlightLevelUnit = 'A' // Altitude, in hundreds of feet
+	FlightLevelMetres FlightLevelUnit = 'S' // Standard metric level, in tens of metres
+	AltitudeMetres    FlightLevelUnit = 'M' // Altitude, in tens of metres
+)
+
+// A FlightLevel is a level or an altitude, such as F350 or M0840
+type FlightLevel struct {
+	Unit  FlightLevelUnit
+	Value int // Value is in hundreds of feet or in tens of metres, depending on the unit
+}
+
+// flightLevelDigits are the number of digits of a flight level for each unit
+var flightLevelDigits = map[FlightLevelUnit]int{
+	FlightLevelFeet:   3,
+	AltitudeFeet:      3,
+	FlightLevelMetres: 4,
+	AltitudeMetres:    4,
+}
+
+// ParseFlightLevel parses a flight level, such as F350, A045, S1130 or M0840
+func ParseFlightLevel(str string) (FlightLevel, error) {
+	if str == "" {
+		return FlightLevel{}, errors.New("ParseFlightLevel: empty flight level")
+	}
+	unit := FlightLevelUnit(str[0])
+	digits, ok := flightLevelDigits[unit]
+	if !ok {
+		return FlightLevel{}, errors.Errorf("ParseFlightLevel: unknown unit %q in %q", str[0], str)
+	}
+	val, err := parseDigits(str[1:], digits)
+	if err != nil {
+		return FlightLevel{}, errors.Wrapf(err, "ParseFlightLevel: invalid flight level %q", str)
+	}
+	return FlightLevel{Unit: unit, Value: val}, nil
+}
+
+// Feet returns the level in feet
+func (fl FlightLevel) Feet() float64 {
+	switch fl.Unit {
+	case FlightLevelFeet, AltitudeFeet:
+		return float64(fl.Value) * 100
+	default:
+		return float64(fl.Value) * 10 / metresPerFoot
+	}
+}
+
+// Metres returns the level in metres
+func (fl FlightLevel) Metres() float64 {
+	switch fl.Unit {
+	case FlightLevelMetres, AltitudeMetres:
+		return float64(fl.Value) * 10
+	default:
+		return float64(fl.Value) * 100 * metresPerFoot
+	}
+}
+
+// String returns the flight level as written in ADEXP
+func (fl FlightLevel) String() string {
+	return fmt.Sprintf("%c%0*d", fl.Unit, flightLevelDigits[fl.Unit], fl.Value)
+}
+
+// A SpeedUnit indicates how a Speed is expressed
+type SpeedUnit byte
+
+// These are the units of speeds defined by the specification
+const (
+	Knots             SpeedUnit = 'N' // True air speed, in knots
+	Mach              SpeedUnit = 'M' // Mach number, in hundredths
+	KilometresPerHour SpeedUnit = 'K' // True air speed, in kilometres per hour
+)
+
+// A Speed is a speed, such as N0450, M082 or K0830
+type Speed struct {
+	Unit  SpeedUnit
+	Value int // Value is in knots, in kilometres per hour or in hundredths of Mach, depending on the unit
+}
+
+// speedDigits are the number of digits of a speed for each unit
+var speedDigits = map[SpeedUnit]int{
+	Knots:             4,
+	Mach:              3,
+	KilometresPerHour: 4,
+}
+
+// ParseSpeed parses a speed, such as N0450, M082 or K0830
+func ParseSpeed(str string) (Speed, error) {
+	if str == "" {
+		return Speed{}, errors.New("ParseSpeed: empty speed")
+	}
+	unit := SpeedUnit(str[0])
+	digits, ok := speedDigits[unit]
+	if !ok {
+		return Speed{}, errors.Errorf("ParseSpeed: unknown unit %q in %q", str[0], str)
+	}
+	val, err := parseDigits(str[1:], digits)
+	if err != nil {
+		return Speed{}, errors.Wrapf(err, "ParseSpeed: invalid speed %q", str)
+	}
+	return Speed{Unit: unit, Value: val}, nil
+}
+
+// Knots returns the speed in knots, ok is false for a Mach number
+func (s Speed) Knots() (knots float64, ok bool) {
+	switch s.Unit {
+	case Knots:
+		return float64(s.Value), true
+	case KilometresPerHour:
+		return float64(s.Value) / kilometresPerNauticalMile, true
+	default:
+		return 0, false
+	}
+}
+
+// Mach returns the Mach number, ok is false for a true air speed
+func (s Speed) Mach() (mach float64, ok bool) {
+	if s.Unit != Mach {
+		return 0, false
+	}
+	return float64(s.Value) / 100, true
+}
+
+// String returns the speed as written in ADEXP
+func (s Speed) String() string {
+	return fmt.Sprintf("%c%0*d", s.Unit, speedDigits[s.Unit], s.Value)
+}
+
+const (
+	metresPerFoot             = 0.3048
+	kilometresPerNauticalMile = 1.852
+)
+
+// parseDigits parses a number made of exactly n digits
+func parseDigits(str string, n int) (int, error) {
+	if len(str) != n {
+		return 0, errors.Errorf("expected %d digits, got %q", n, str)
+	}
+	for _, r := range str {
+		if r < '0' || r > '9' {
+			return 0, errors.Errorf("invalid digit %q in %q", r, str)
+		}
+	}
+	return strconv.Atoi(str)
+}
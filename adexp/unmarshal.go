@@ -1,36 +1,81 @@
 package adexp
 
 import (
+	"bufio"
 	"bytes"
+	"encoding"
+	"fmt"
 	"io"
+	"reflect"
+	"strconv"
 
+	"github.com/aabizri/aero/adexp/lexer"
+	lexondemand "github.com/aabizri/aero/adexp/lexer/ondemand"
+	"github.com/aabizri/aero/adexp/lexer/scannify"
 	"github.com/aabizri/aero/adexp/parser"
+	"github.com/aabizri/aero/adexp/parser/ondemand"
 	"github.com/pkg/errors"
 )
 
 // UnmarshalText unmarshals the given text to msg.
+// Only its first message is decoded, use a Decoder to decode them all.
+// A text without any message, such as an empty one, leaves msg untouched.
 // Note that it is much more efficient to use Decoder with a streaming io.Reader.
 func (msg ADEXP) UnmarshalText(text []byte) error {
 	r := bytes.NewReader(text)
 	enc := NewDecoder(r)
-	err := enc.Decode(msg)
+	return noMessage(enc.Decode(msg))
+}
+
+// Unmarshal decodes the ADEXP text in data and stores the result in the struct pointed to by v.
+// A text without any message leaves v untouched.
+//
+// Struct fields are matched to ADEXP fields via their "adexp" tag, see Decoder.DecodeValue for the details.
+func Unmarshal(data []byte, v interface{}) error {
+	dec := NewDecoder(bytes.NewReader(data))
+	return noMessage(dec.DecodeValue(v))
+}
+
+// noMessage returns nil for io.EOF, which the Decoder returns when there is no message to decode
+func noMessage(err error) error {
+	if err == io.EOF {
+		return nil
+	}
 	return err
 }
 
-// A Decoder decodes an input stream to an ADEXP map
+// A Decoder decodes an input stream to ADEXP maps.
+//
+// The stream may hold several messages, each starting with its TITLE field: every call to Decode decodes the next one.
 type Decoder struct {
 	reader     io.Reader
 	parserFunc func(io.Reader) parser.Parser
 	parser     parser.Parser
 
 	started bool
+
+	// next is the expression read ahead, which belongs to the next message
+	next *parser.Expression
+	// err is the error which stopped the parsing, returned again by the later calls
+	err error
 }
 
 // NewDecoder returns a default Decoder.
+// It uses the on-demand lexer & parser, use SetParser to change that.
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{
-		reader: r,
+		reader:     r,
+		parserFunc: defaultParser,
+	}
+}
+
+// defaultParser returns an on-demand parser over an on-demand lexer reading from r
+func defaultParser(r io.Reader) parser.Parser {
+	rs, ok := r.(io.RuneScanner)
+	if !ok {
+		rs = bufio.NewReader(r)
 	}
+	return ondemand.New(scannify.New(lexondemand.New(rs)))
 }
 
 // SetParser sets the Parser-obtaining function to be used.
@@ -43,8 +88,37 @@ func (dec *Decoder) SetParser(new func(io.Reader) parser.Parser) error {
 	return nil
 }
 
-// Decode decodes the input stream to the given ADEXP msg
-func (dec *Decoder) Decode(msg ADEXP) error {
+// A SyntaxError is an error in the syntax of an ADEXP document, located in the input
+type SyntaxError struct {
+	Line    int    // Line is the line of the error, starting at 1
+	Column  int    // Column is the column of the error, in runes and starting at 1
+	Offset  int    // Offset is the byte offset of the error from the start of the input
+	Snippet string // Snippet is the input leading to the error, or the offending field
+	Msg     string // Msg describes the error
+}
+
+// newSyntaxError returns the SyntaxError of a lexing or parsing error
+func newSyntaxError(le *lexer.Error) *SyntaxError {
+	return &SyntaxError{
+		Line:    le.Pos.Line,
+		Column:  le.Pos.Column,
+		Offset:  le.Pos.Offset,
+		Snippet: le.Snippet,
+		Msg:     le.Err.Error(),
+	}
+}
+
+// Error implements error
+func (se *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d (offset %d): %s, near %q", se.Line, se.Column, se.Offset, se.Msg, se.Snippet)
+}
+
+// start builds the parser on the first call
+func (dec *Decoder) start() {
+	if dec.started {
+		return
+	}
+
 	// Note that we have started
 	dec.started = true
 
@@ -52,46 +126,326 @@ func (dec *Decoder) Decode(msg ADEXP) error {
 	dec.parser = dec.parserFunc(dec.reader)
 	dec.reader = nil
 	dec.parserFunc = nil
+}
+
+// parse returns the next expression, either the one read ahead or a new one from the parser
+func (dec *Decoder) parse() (*parser.Expression, error) {
+	if dec.next != nil {
+		expr := dec.next
+		dec.next = nil
+		return expr, nil
+	}
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	expr, err := dec.parser.Parse()
+	dec.err = err
+	return expr, err
+}
+
+// More reports whether there is another message in the input stream, which may be an invalid one.
+// It reads ahead the first field of the next message.
+func (dec *Decoder) More() bool {
+	dec.start()
+	if dec.next == nil && dec.err == nil {
+		dec.next, dec.err = dec.parser.Parse()
+	}
+	return dec.err != io.EOF || dec.next != nil
+}
+
+// Decode decodes the next message of the input stream to the given ADEXP msg.
+// The message runs until the next TITLE field, which starts the following message, or until the end of the input.
+// If there is no message left, Decode returns io.EOF.
+//
+// Errors in the syntax of the input are returned as a *SyntaxError, locating them.
+// After an error, every later call returns it.
+func (dec *Decoder) Decode(msg ADEXP) error {
+	return dec.decode("Decode", func(keyword string, val value) {
+		msg[keyword] = val
+	})
+}
+
+// DecodeDocument decodes the next message of the input stream to the given document, appending its fields in order.
+// It follows Decode otherwise.
+func (dec *Decoder) DecodeDocument(doc *Document) error {
+	return dec.decode("DecodeDocument", func(keyword string, val value) {
+		*doc = append(*doc, Field{Keyword: keyword, value: val})
+	})
+}
+
+// decode decodes the next message of the input stream, calling add for each of its fields in order.
+// name is the name of the calling function, for the errors.
+func (dec *Decoder) decode(name string, add func(keyword string, val value)) error {
+	dec.start()
 
 	// Now we parse
-Loop:
 	for i := 0; ; i++ {
-		expr, err := dec.parser.Parse()
+		expr, err := dec.parse()
 
 		// Check for parsing errors
-		switch err {
-		// If we get an EOF, we quit
-		case io.EOF:
-			break Loop
-		case nil:
+		switch {
+		// If we get an EOF before any field, there is no message left
+		case err == io.EOF && i == 0:
+			return io.EOF
+		// If we get an EOF, the message is over
+		case err == io.EOF:
+			return nil
+		case err == nil:
 		// If we have an unexpected error
 		default:
-			return errors.Wrapf(err, "Decode (expression %d): parsing error", i)
+			if le, ok := errors.Cause(err).(*lexer.Error); ok {
+				return newSyntaxError(le)
+			}
+			return errors.Wrapf(err, "%s (expression %d): parsing error", name, i)
 		}
 
 		// Check that expr isn't nil, it shouldn't !
 		if expr == nil {
-			return errors.Errorf("Decode (expression %d): we got an unexpected nil expression", i)
+			return errors.Errorf("%s (expression %d): we got an unexpected nil expression", name, i)
+		}
+
+		// A TITLE field after the first one starts the next message, so we keep it for the next call
+		if i != 0 && expr.Keyword == parser.TITLEKeyword {
+			dec.next = expr
+			return nil
 		}
 
 		// Now apply that to our map
-		switch expr.Kind {
-		case parser.Primary:
-			if pf, ok := expr.Value.(parser.PrimaryField); ok {
-				val := value{
-					kind:  Primary,
-					value: string(pf),
-				}
-				msg[expr.Keyword] = val
-			} else {
-				return errors.Errorf("Decode (expression %d): parser indicated kind %s but it doesn't match with value (%T)", i, expr.Kind, expr)
+		val, err := valueFromExpression(expr)
+		if err != nil {
+			return errors.Wrapf(err, "%s (expression %d)", name, i)
+		}
+		add(expr.Keyword, val)
+	}
+}
+
+// valueFromExpression converts a parsed expression to a value
+func valueFromExpression(expr *parser.Expression) (value, error) {
+	switch expr.Kind {
+	case parser.Primary:
+		pf, ok := expr.Value.(parser.PrimaryField)
+		if !ok {
+			return value{}, errors.Errorf("valueFromExpression (%s): parser indicated kind %s but it doesn't match with value (%T)", expr.Keyword, expr.Kind, expr.Value)
+		}
+		return value{kind: Primary, value: string(pf)}, nil
+
+	case parser.Structured:
+		sf, ok := expr.Value.(parser.StructuredField)
+		if !ok {
+			return value{}, errors.Errorf("valueFromExpression (%s): parser indicated kind %s but it doesn't match with value (%T)", expr.Keyword, expr.Kind, expr.Value)
+		}
+		mul := Multi{
+			m:     make(map[string]value, len(sf)),
+			items: make([]entry, 0, len(sf)),
+			kind:  Structured,
+		}
+		for i := range sf {
+			val, err := valueFromExpression(&sf[i])
+			if err != nil {
+				return value{}, errors.Wrapf(err, "valueFromExpression (%s): error in subfield %s", expr.Keyword, sf[i].Keyword)
+			}
+			mul.items = append(mul.items, entry{keyword: sf[i].Keyword, value: val})
+
+			// Only the first subfield with a given keyword is directly accessible, as for lists
+			if _, ok := mul.m[sf[i].Keyword]; !ok {
+				mul.m[sf[i].Keyword] = val
 			}
-		// TODO:
-		case parser.Structured:
-		case parser.List:
+		}
+		return value{kind: Structured, value: mul}, nil
+
+	case parser.List:
+		lf, ok := expr.Value.(parser.ListField)
+		if !ok {
+			return value{}, errors.Errorf("valueFromExpression (%s): parser indicated kind %s but it doesn't match with value (%T)", expr.Keyword, expr.Kind, expr.Value)
+		}
+		mul := Multi{
+			m:     make(map[string]value),
+			items: make([]entry, 0, len(lf)),
+			kind:  List,
+		}
+		for i := range lf {
+			val, err := valueFromExpression(&lf[i])
+			if err != nil {
+				return value{}, errors.Wrapf(err, "valueFromExpression (%s): error in element #%d", expr.Keyword, i)
+			}
+			mul.items = append(mul.items, entry{keyword: lf[i].Keyword, value: val})
+
+			// Only the first element with a given keyword is directly accessible
+			if _, ok := mul.m[lf[i].Keyword]; !ok {
+				mul.m[lf[i].Keyword] = val
+			}
+		}
+		return value{kind: List, value: mul}, nil
+
+	default:
+		return value{}, errors.Errorf("valueFromExpression (%s): unknown kind %s", expr.Keyword, expr.Kind)
+	}
+}
+
+// DecodeValue decodes the next message of the input stream to the struct pointed to by v, returning io.EOF if there is none left.
+//
+// Each exported field is associated with the keyword given by its "adexp" tag, or its upper-cased name if there is none.
+// A tag of "-" ignores the field. Fields whose keyword is absent from the message are left untouched.
+//
+// Primary fields can be decoded into strings, integers, floats, time.Time and encoding.TextUnmarshaler implementations.
+// Times are decoded in UTC, as by ParseTime, from the format given by the "date", "time", "datetime" or "seconds" option if any.
+// Structured fields are decoded into structs, and list fields into slices.
+// By default every element of a list is decoded, a tag such as `adexp:"ADDR>FAC"` restricts it to the elements with the keyword FAC.
+func (dec *Decoder) DecodeValue(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("DecodeValue: expected a non-nil pointer to a struct, got %T", v)
+	}
+
+	msg := ADEXP{}
+	if err := dec.Decode(msg); err == io.EOF {
+		return err
+	} else if err != nil {
+		return errors.Wrap(err, "DecodeValue: error while decoding")
+	}
+
+	return errors.Wrap(decodeStruct(msg, rv.Elem()), "DecodeValue")
+}
+
+// decodeStruct decodes the given fields into the struct rv
+func decodeStruct(m map[string]value, rv reflect.Value) error {
+	for _, fi := range structFields(rv.Type()) {
+		val, ok := m[fi.keyword]
+		if !ok {
+			continue
+		}
+		if err := decodeValue(val, rv.Field(fi.index), fi.element, fi.timeLayout); err != nil {
+			return errors.Wrapf(err, "field %s (%s)", fi.name, fi.keyword)
+		}
+	}
+	return nil
+}
+
+// decodeValue decodes a value into rv.
+// element is the keyword of the elements to be decoded if val is a list, if empty all elements are decoded.
+// layout is the format of a time.Time, if empty it is told by the length of the value.
+func decodeValue(val value, rv reflect.Value, element string, layout string) error {
+	// Allocate pointers as needed
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return decodeValue(val, rv.Elem(), element, layout)
+	}
+
+	// Times and custom unmarshalers take precedence, time.Time being a TextUnmarshaler of RFC 3339
+	if rv.Type() == timeType {
+		str, err := primaryString(val)
+		if err != nil {
+			return err
+		}
+		if layout == "" {
+			layout = timeLayouts[len(str)]
+		}
+		t, err := parseTime(str, layout)
+		if err != nil {
+			return errors.Wrapf(err, "cannot decode %q into a time", str)
+		}
+		rv.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if rv.CanAddr() {
+		if tu, ok := rv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			str, err := primaryString(val)
+			if err != nil {
+				return err
+			}
+			return tu.UnmarshalText([]byte(str))
 		}
 	}
 
-	// And finished !
+	switch rv.Kind() {
+	case reflect.String:
+		str, err := primaryString(val)
+		if err != nil {
+			return err
+		}
+		rv.SetString(str)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		str, err := primaryString(val)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(str, 10, rv.Type().Bits())
+		if err != nil {
+			return errors.Wrapf(err, "cannot decode %q into %s", str, rv.Type())
+		}
+		rv.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		str, err := primaryString(val)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseUint(str, 10, rv.Type().Bits())
+		if err != nil {
+			return errors.Wrapf(err, "cannot decode %q into %s", str, rv.Type())
+		}
+		rv.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		str, err := primaryString(val)
+		if err != nil {
+			return err
+		}
+		f, err := strconv.ParseFloat(str, rv.Type().Bits())
+		if err != nil {
+			return errors.Wrapf(err, "cannot decode %q into %s", str, rv.Type())
+		}
+		rv.SetFloat(f)
+
+	case reflect.Struct:
+		if val.kind != Structured {
+			return errors.Errorf("cannot decode a %s into a struct", val.kind)
+		}
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return errors.Errorf("kind %s but value of type %T", val.kind, val.value)
+		}
+		return decodeStruct(mul.m, rv)
+
+	case reflect.Slice:
+		if val.kind != List {
+			return errors.Errorf("cannot decode a %s into a slice", val.kind)
+		}
+		mul, ok := val.value.(Multi)
+		if !ok {
+			return errors.Errorf("kind %s but value of type %T", val.kind, val.value)
+		}
+		slice := reflect.MakeSlice(rv.Type(), 0, len(mul.items))
+		for i, e := range mul.items {
+			if element != "" && e.keyword != element {
+				continue
+			}
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			if err := decodeValue(e.value, elem, "", layout); err != nil {
+				return errors.Wrapf(err, "list element #%d (%s)", i, e.keyword)
+			}
+			slice = reflect.Append(slice, elem)
+		}
+		rv.Set(slice)
+
+	default:
+		return errors.Errorf("unsupported type %s", rv.Type())
+	}
+
 	return nil
 }
+
+// primaryString returns the text of a primary value
+func primaryString(val value) (string, error) {
+	if val.kind != Primary {
+		return "", errors.Errorf("expected a %s, got a %s", Primary, val.kind)
+	}
+	str, ok := val.value.(string)
+	if !ok {
+		return "", errors.Errorf("kind %s but value of type %T", val.kind, val.value)
+	}
+	return str, nil
+}
@@ -0,0 +1,275 @@
+package adexp
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aabizri/aero/adexp/parser"
+	"github.com/pkg/errors"
+)
+
+// sliceParser is a parser.Parser returning pre-defined expressions
+type sliceParser []parser.Expression
+
+func (sp *sliceParser) Parse() (*parser.Expression, error) {
+	if len(*sp) == 0 {
+		return nil, io.EOF
+	}
+	expr := (*sp)[0]
+	*sp = (*sp)[1:]
+	return &expr, nil
+}
+
+func TestDecoder_Decode_Structured(t *testing.T) {
+	exprs := sliceParser{
+		{Kind: parser.Primary, Keyword: "TITLE", Value: parser.PrimaryField("SAM")},
+		{Kind: parser.Structured, Keyword: "GEO", Value: parser.StructuredField{
+			{Kind: parser.Primary, Keyword: "GEOID", Value: parser.PrimaryField("01")},
+			{Kind: parser.Primary, Keyword: "LATTD", Value: parser.PrimaryField("520000N")},
+			{Kind: parser.Primary, Keyword: "LONGTD", Value: parser.PrimaryField("0150000W")},
+		}},
+	}
+	dec := NewDecoder(nil)
+	dec.SetParser(func(io.Reader) parser.Parser { return &exprs })
+
+	msg := ADEXP{}
+	if err := dec.Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	geo, ok := msg.GetStructured("GEO")
+	if !ok {
+		t.Fatalf("GEO not found as a structured field")
+	}
+	if lattd, ok := geo.GetPrimary("LATTD"); !ok || lattd != "520000N" {
+		t.Errorf("unexpected LATTD: got (%q, %t)", lattd, ok)
+	}
+}
+
+func TestDecoder_Decode_List(t *testing.T) {
+	const text = "-TITLE SAM -ARCID AFR456 -BEGIN ADDR -FAC LLEVZPZX -FAC LFFFZQZX -END ADDR"
+	msg := ADEXP{}
+	if err := NewDecoder(strings.NewReader(text)).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+
+	if arcid, ok := msg.GetPrimary("ARCID"); !ok || arcid != "AFR456" {
+		t.Errorf("unexpected ARCID: got (%q, %t)", arcid, ok)
+	}
+
+	addr, ok := msg.GetList("ADDR")
+	if !ok {
+		t.Fatalf("ADDR not found as a list field")
+	}
+	if fac, ok := addr.GetPrimary("FAC"); !ok || fac != "LLEVZPZX" {
+		t.Errorf("unexpected first FAC: got (%q, %t)", fac, ok)
+	}
+	if len(addr.items) != 2 || addr.items[1].value.value != "LFFFZQZX" {
+		t.Errorf("unexpected list elements: %v", addr.items)
+	}
+}
+
+// callsign is a custom encoding.TextUnmarshaler
+type callsign struct {
+	operator string
+	number   string
+}
+
+func (c *callsign) UnmarshalText(text []byte) error {
+	if len(text) < 3 {
+		return errors.Errorf("callsign %q too short", text)
+	}
+	c.operator, c.number = string(text[:3]), string(text[3:])
+	return nil
+}
+
+type testPoint struct {
+	ID  string    `adexp:"PTID"`
+	FL  string    `adexp:"FL"`
+	ETO time.Time `adexp:"ETO"`
+}
+
+type testFlight struct {
+	Title     string      `adexp:"TITLE"`
+	Callsign  callsign    `adexp:"ARCID"`
+	EOBD      time.Time   `adexp:"EOBD"`
+	EOBT      *time.Time  `adexp:"EOBT"`
+	Seats     int         `adexp:"SEATS"`
+	Addresses []string    `adexp:"ADDR>FAC"`
+	Points    []testPoint `adexp:"RTEPTS>PT"`
+	Geo       struct {
+		ID       string `adexp:"GEOID"`
+		Latitude string `adexp:"LATTD"`
+	}
+	Ignored string `adexp:"-"`
+}
+
+func TestDecoder_Decode_Multiple(t *testing.T) {
+	const text = "-TITLE IFPL -ARCID AFR456 -BEGIN ADDR -FAC LLEVZPZX -END ADDR\n" +
+		"-TITLE ICHG -ARCID BAW123 -GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W\n" +
+		"-TITLE IACH -ARCID DLH789\n"
+	expected := []struct {
+		title, arcid string
+		fields       int
+	}{{"IFPL", "AFR456", 3}, {"ICHG", "BAW123", 3}, {"IACH", "DLH789", 2}}
+
+	dec := NewDecoder(strings.NewReader(text))
+	for i, e := range expected {
+		if !dec.More() {
+			t.Fatalf("message %d: More returned false", i)
+		}
+		msg := ADEXP{}
+		if err := dec.Decode(msg); err != nil {
+			t.Fatalf("message %d: error while decoding: %v", i, err)
+		}
+		if title, _ := msg.GetPrimary("TITLE"); title != e.title {
+			t.Errorf("message %d: unexpected TITLE %q, expected %q", i, title, e.title)
+		}
+		if arcid, _ := msg.GetPrimary("ARCID"); arcid != e.arcid {
+			t.Errorf("message %d: unexpected ARCID %q, expected %q", i, arcid, e.arcid)
+		}
+		if len(msg) != e.fields {
+			t.Errorf("message %d: expected %d fields, got %d", i, e.fields, len(msg))
+		}
+	}
+	if dec.More() {
+		t.Errorf("More returned true at the end of the input")
+	}
+	if err := dec.Decode(ADEXP{}); err != io.EOF {
+		t.Errorf("expected io.EOF at the end of the input, got %v", err)
+	}
+
+	// Errors stop the decoding
+	dec = NewDecoder(strings.NewReader("-TITLE IFPL -ARCID AFR456 -TITLE ICHG -ADEP lfpg -TITLE IACH -ARCID DLH789"))
+	if err := dec.Decode(ADEXP{}); err != nil {
+		t.Fatalf("error while decoding the first message: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if !dec.More() {
+			t.Errorf("More returned false despite the error")
+		}
+		if _, ok := dec.Decode(ADEXP{}).(*SyntaxError); !ok {
+			t.Errorf("expected a *SyntaxError for the second message")
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	const text = "-TITLE IFPL -ARCID AFR456 -EOBD 140110 -EOBT 0900 -SEATS 180 -ADEP LFPG " +
+		"-GEO -GEOID 01 -LATTD 520000N -LONGTD 0150000W " +
+		"-BEGIN ADDR -FAC LLEVZPZX -FAC LFFFZQZX -END ADDR " +
+		"-BEGIN RTEPTS -PT -PTID XETBO -FL F350 -ETO 140110093000 -VEC -FL F350 -ETO 140110093500 -RELDIST 10 -PT -PTID BUBLI -FL F350 -ETO 140110094000 -END RTEPTS"
+
+	var f testFlight
+	if err := Unmarshal([]byte(text), &f); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	switch {
+	case f.Title != "IFPL":
+		t.Errorf("unexpected title %q", f.Title)
+	case f.Callsign != callsign{"AFR", "456"}:
+		t.Errorf("unexpected callsign %v", f.Callsign)
+	case !f.EOBD.Equal(time.Date(2014, 1, 10, 0, 0, 0, 0, time.UTC)):
+		t.Errorf("unexpected EOBD %v", f.EOBD)
+	case f.EOBT == nil || f.EOBT.Hour() != 9:
+		t.Errorf("unexpected EOBT %v", f.EOBT)
+	case f.Seats != 180:
+		t.Errorf("unexpected seats %d", f.Seats)
+	case !reflect.DeepEqual(f.Addresses, []string{"LLEVZPZX", "LFFFZQZX"}):
+		t.Errorf("unexpected addresses %v", f.Addresses)
+	case f.Geo.ID != "01" || f.Geo.Latitude != "520000N":
+		t.Errorf("unexpected geo %v", f.Geo)
+	}
+
+	if len(f.Points) != 2 {
+		t.Fatalf("expected 2 points, got %d: %v", len(f.Points), f.Points)
+	}
+	if f.Points[1].ID != "BUBLI" || !f.Points[1].ETO.Equal(time.Date(2014, 1, 10, 9, 40, 0, 0, time.UTC)) {
+		t.Errorf("unexpected second point %v", f.Points[1])
+	}
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	var f testFlight
+	if err := Unmarshal([]byte("-TITLE IFPL"), f); err == nil {
+		t.Errorf("expected an error when unmarshalling into a non-pointer")
+	}
+	if err := Unmarshal([]byte("-TITLE IFPL -SEATS ABC"), &f); err == nil {
+		t.Errorf("expected an error when unmarshalling a non-number into an int")
+	}
+	if err := Unmarshal([]byte("-TITLE IFPL -GEO -GEOID 01"), &struct {
+		Geo string `adexp:"GEO"`
+	}{}); err == nil {
+		t.Errorf("expected an error when unmarshalling a structured field into a string")
+	}
+}
+
+func TestUnmarshal_Empty(t *testing.T) {
+	for _, text := range []string{"", " \r\n\t"} {
+		msg := ADEXP{}
+		if err := msg.UnmarshalText([]byte(text)); err != nil || len(msg) != 0 {
+			t.Errorf("%q: ADEXP.UnmarshalText: unexpected result %v (%v)", text, msg, err)
+		}
+		doc := Document{{Keyword: "TITLE"}}
+		if err := doc.UnmarshalText([]byte(text)); err != nil || len(doc) != 0 {
+			t.Errorf("%q: Document.UnmarshalText: unexpected result %v (%v)", text, doc, err)
+		}
+		var f testFlight
+		if err := Unmarshal([]byte(text), &f); err != nil {
+			t.Errorf("%q: Unmarshal: unexpected error %v", text, err)
+		}
+
+		// A Decoder reports the end of the stream
+		if err := NewDecoder(strings.NewReader(text)).Decode(ADEXP{}); err != io.EOF {
+			t.Errorf("%q: Decoder.Decode: expected io.EOF, got %v", text, err)
+		}
+	}
+}
+
+func TestDecoder_Decode_SyntaxError(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected SyntaxError
+	}{
+		{
+			"-TITLE IFPL\n-ARCID AFR456\n-ADEP lfpg\n",
+			SyntaxError{Line: 3, Column: 7, Offset: 32, Snippet: "-ADEP l"},
+		},
+		{
+			"-TITLE IFPL\n-BEGIN ADDR\n  -FAC LLEVZPZX\n-END RTEPTS\n",
+			SyntaxError{Line: 4, Column: 6, Offset: 45, Snippet: "RTEPTS"},
+		},
+		{
+			"-ARCID AFR456",
+			SyntaxError{Line: 1, Column: 1, Offset: 0, Snippet: "ARCID"},
+		},
+		{
+			"-TITLE IFPL -ARCID",
+			SyntaxError{Line: 1, Column: 19, Offset: 18, Snippet: "-TITLE IFPL -ARCID"},
+		},
+		{
+			"-TITLE IFPL\n-BEGIN RTEPTS\n  -PT -PTID XETBO",
+			SyntaxError{Line: 3, Column: 13, Offset: 38, Snippet: "XETBO"},
+		},
+	}
+	for _, test := range tests {
+		err := NewDecoder(strings.NewReader(test.text)).Decode(ADEXP{})
+		se, ok := err.(*SyntaxError)
+		if !ok {
+			t.Errorf("%q: expected a *SyntaxError, got %v", test.text, err)
+			continue
+		}
+		if se.Line != test.expected.Line || se.Column != test.expected.Column || se.Offset != test.expected.Offset || se.Snippet != test.expected.Snippet {
+			t.Errorf("%q: got %d:%d (offset %d, near %q), expected %d:%d (offset %d, near %q)", test.text,
+				se.Line, se.Column, se.Offset, se.Snippet,
+				test.expected.Line, test.expected.Column, test.expected.Offset, test.expected.Snippet)
+		}
+		if se.Msg == "" {
+			t.Errorf("%q: empty message", test.text)
+		}
+	}
+}
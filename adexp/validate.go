@@ -0,0 +1,188 @@
+package adexp
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aabizri/aero/adexp/catalog"
+	"github.com/aabizri/aero/adexp/parser"
+	"github.com/aabizri/aero/adexp/syntax"
+)
+
+// A ValidationError describes a field that doesn't conform to the specification
+type ValidationError struct {
+	Keyword string
+	Path    string // Path locates the field in the message, such as "RTEPTS/PT[1]/FL", list indexes start at 0 and count the elements of the same keyword
+	Value   string // Value is the value of a primary field, empty otherwise
+	Reason  string
+}
+
+// Error implements error
+func (ve ValidationError) Error() string {
+	if ve.Value != "" {
+		return fmt.Sprintf("%s: %q %s", ve.Path, ve.Value, ve.Reason)
+	}
+	return fmt.Sprintf("%s: %s", ve.Path, ve.Reason)
+}
+
+// ValidationErrors holds all the validation errors found in a message
+type ValidationErrors []ValidationError
+
+// Error implements error
+func (ves ValidationErrors) Error() string {
+	strs := make([]string, len(ves))
+	for i, ve := range ves {
+		strs[i] = ve.Error()
+	}
+	return fmt.Sprintf("%d invalid field(s): %s", len(ves), strings.Join(strs, "; "))
+}
+
+// Validate checks the message against the ADEXP specification, and returns all the problems found as ValidationErrors.
+//
+// The kind of each field, the subfields allowed in compound fields and the syntax of primary values are checked.
+// Keywords that aren't defined by the specification are ignored, as are the few fields whose syntax definition can't be compiled.
+// The rule of the message's title (see catalog.LookupRule) is checked too: missing mandatory fields and fields it doesn't allow are reported.
+func Validate(msg ADEXP) error {
+	var ves ValidationErrors
+	for _, keyword := range sortedKeys(msg) {
+		ves = validateField(ves, "", "", keyword, -1, msg[keyword])
+	}
+	ves = validateTitle(ves, msg)
+	if len(ves) == 0 {
+		return nil
+	}
+	return ves
+}
+
+// validateTitle appends the problems of the message as a whole to ves, according to the rule of its title
+func validateTitle(ves ValidationErrors, msg ADEXP) ValidationErrors {
+	report := func(keyword string, value string, reason string, args ...interface{}) {
+		ves = append(ves, ValidationError{Keyword: keyword, Path: keyword, Value: value, Reason: fmt.Sprintf(reason, args...)})
+	}
+
+	title, ok := msg.GetPrimary(parser.TITLEKeyword)
+	if !ok {
+		if _, ok := msg[parser.TITLEKeyword]; !ok {
+			report(parser.TITLEKeyword, "", "is missing")
+		}
+		return ves
+	}
+	if _, ok := catalog.LookupTitle(title); !ok {
+		report(parser.TITLEKeyword, title, "is not a message title of the specification")
+		return ves
+	}
+
+	rule, ok := catalog.LookupRule(title)
+	if !ok {
+		return ves
+	}
+	for _, keyword := range rule.Mandatory {
+		if _, ok := msg[keyword]; !ok {
+			report(keyword, "", "is mandatory in %s messages", title)
+		}
+	}
+	for _, keyword := range sortedKeys(msg) {
+		if !rule.Allows(keyword) {
+			report(keyword, "", "is not allowed in %s messages", title)
+		}
+	}
+	return ves
+}
+
+// validateField appends the problems of the given field to ves.
+// parent is the keyword of the enclosing compound field if any, and index the index of the field in a list, or -1.
+func validateField(ves ValidationErrors, parent string, path string, keyword string, index int, val value) ValidationErrors {
+	if path != "" {
+		path += "/"
+	}
+	path += keyword
+	if index >= 0 {
+		path += "[" + strconv.Itoa(index) + "]"
+	}
+
+	report := func(value string, reason string, args ...interface{}) {
+		ves = append(ves, ValidationError{Keyword: keyword, Path: path, Value: value, Reason: fmt.Sprintf(reason, args...)})
+	}
+
+	f, ok := catalog.Lookup(keyword)
+	if !ok {
+		return ves
+	}
+	if parent != "" && !catalog.Allowed(parent, keyword) {
+		report("", "is not allowed in %s", parent)
+	}
+
+	expected := Primary
+	switch {
+	case f.List:
+		expected = List
+	case f.Structured():
+		expected = Structured
+	}
+	if val.kind != expected {
+		report("", "is a %s, expected a %s", val.kind, expected)
+		return ves
+	}
+
+	switch val.kind {
+	case Primary:
+		str, _ := val.value.(string)
+		if m := matcherFor(f); m != nil && !m.Match(str) {
+			report(str, "does not match the syntax %s", m)
+		}
+
+	case Structured:
+		mul, _ := val.value.(Multi)
+		if mul.items != nil {
+			for _, e := range mul.items {
+				ves = validateField(ves, keyword, path, e.keyword, -1, e.value)
+			}
+			break
+		}
+		for _, k := range sortedKeys(mul.m) {
+			ves = validateField(ves, keyword, path, k, -1, mul.m[k])
+		}
+
+	case List:
+		mul, _ := val.value.(Multi)
+		counts := make(map[string]int)
+		for _, e := range mul.items {
+			ves = validateField(ves, keyword, path, e.keyword, counts[e.keyword], e.value)
+			counts[e.keyword]++
+		}
+	}
+	return ves
+}
+
+// valuePrefix matches the "'-' KEYWORD" part of a field syntax
+var valuePrefix = regexp.MustCompile(`^'-'\s*"?\s*[A-Z0-9]+\s*"?\s*`)
+
+var (
+	matchersMu sync.Mutex
+	matchers   = make(map[string]*syntax.Matcher) // nil for the fields whose syntax can't be compiled
+)
+
+// matcherFor returns the matcher of the values of the field, or nil if it has none
+func matcherFor(f *catalog.Field) *syntax.Matcher {
+	matchersMu.Lock()
+	defer matchersMu.Unlock()
+
+	m, ok := matchers[f.Keyword]
+	if !ok {
+		m, _ = syntax.Compile(valuePrefix.ReplaceAllString(f.Syntax, ""), resolveTerm)
+		matchers[f.Keyword] = m
+	}
+	return m
+}
+
+// resolveTerm is a syntax.Resolver for the auxiliary terms of the catalog
+func resolveTerm(name string) (string, bool) {
+	t, ok := catalog.LookupTerm(name)
+	if !ok {
+		return "", false
+	}
+	return t.Syntax, true
+}
@@ -0,0 +1,109 @@
+package adexp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE IFPL -IFPLID AA12345678 -ARCID AFR456 -ARCTYP A320 -FLTRUL I -FLTTYP S " +
+		"-ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0900 -RFL F350 -ROUTE N0450F350 DCT " +
+		"-BEGIN ADDR -FAC LLEVZPZX -FAC LFFFZQZX -END ADDR")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	if err := Validate(msg); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+}
+
+func TestValidate_IFPL(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE IFPL -IFPLID AA12345678 -ARCID AFR456 -SSRCODE A2345 -ARCTYP A320 -WKTRC M -FLTRUL I -FLTTYP S " +
+		"-CEQPT SDFGRWY -SEQPT S -ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0900 -RFL F350 -SPEED N0450 " +
+		"-ROUTE N0450F350 XETBO DCT 5200N01500W DCT BUBLI -TTLEET 0130 -ALTRNT1 EGKK -PBN B1D1 -REG FHBNA " +
+		"-GEO -GEOID GEO01 -LATTD 520000N -LONGTD 0150000W " +
+		"-BEGIN RTEPTS -PT -PTID XETBO -FL F350 -ETO 140110093000 -PT -PTID GEO01 -FL F350 -ETO 140110100000 " +
+		"-PT -PTID BUBLI -FL F350 -ETO 140110101500 -END RTEPTS")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	if err := Validate(msg); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+}
+
+func TestValidate_Errors(t *testing.T) {
+	msg := ADEXP{}
+	err := NewDecoder(strings.NewReader("-TITLE APL -ARCID AFR456 -ADEP 12345 -RFL 350 " +
+		"-BEGIN RTEPTS -PT -PTID XETBO -FL F350 -PT -PTID BUBLI -FL 35 -END RTEPTS")).Decode(msg)
+	if err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	msg["ADES"] = value{kind: Structured, value: Multi{m: map[string]value{}, kind: Structured}}
+
+	err = Validate(msg)
+	ves, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T (%v)", err, err)
+	}
+
+	expected := map[string]string{
+		"ADEP":            "12345",
+		"ADES":            "",
+		"RFL":             "350",
+		"RTEPTS/PT[1]/FL": "35",
+	}
+	if len(ves) != len(expected) {
+		t.Errorf("expected %d errors, got %d: %v", len(expected), len(ves), ves)
+	}
+	for _, ve := range ves {
+		val, ok := expected[ve.Path]
+		if !ok {
+			t.Errorf("unexpected error %v", ve)
+			continue
+		}
+		if ve.Value != val {
+			t.Errorf("%s: expected value %q, got %q", ve.Path, val, ve.Value)
+		}
+		if !strings.HasSuffix(ve.Path, ve.Keyword) && !strings.Contains(ve.Path, ve.Keyword+"[") {
+			t.Errorf("%s: unexpected keyword %q", ve.Path, ve.Keyword)
+		}
+	}
+}
+
+func TestValidate_Title(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"-TITLE XXX -ARCID AFR456", []string{"TITLE"}},
+		{"-TITLE APL -ARCID AFR456", nil},
+		{"-TITLE SAM -ARCID AFR456 -ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0900 -REGUL LFPGA10 -ROUTE N0450F350 DCT", []string{"CTOT", "ROUTE"}},
+		{"-TITLE SAM -ARCID AFR456 -ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0900 -CTOT 0930 -REGUL LFPGA10 -GEO -GEOID GEO01 -LATTD 520000N -LONGTD 0150000W", nil},
+		{"-TITLE ABI -ARCID AFR456 -ROUTE N0450F350 DCT", nil},
+	}
+
+	for _, test := range tests {
+		msg := ADEXP{}
+		if err := NewDecoder(strings.NewReader(test.text)).Decode(msg); err != nil {
+			t.Errorf("%q: error while decoding: %v", test.text, err)
+			continue
+		}
+		ves, _ := Validate(msg).(ValidationErrors)
+		var got []string
+		for _, ve := range ves {
+			got = append(got, ve.Keyword)
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected errors for %v, got %v", test.text, test.expected, ves)
+		}
+	}
+
+	msg := ADEXP{"ARCID": value{kind: Primary, value: "AFR456"}}
+	if ves, _ := Validate(msg).(ValidationErrors); len(ves) != 1 || ves[0].Keyword != "TITLE" {
+		t.Errorf("expected an error for the missing title, got %v", ves)
+	}
+}
@@ -0,0 +1,417 @@
+package fixm
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aabizri/aero/adexp"
+	"github.com/pkg/errors"
+)
+
+// flightTypes are the FIXM types of flight for each value of FLTTYP
+var flightTypes = map[string]string{
+	"S": Scheduled,
+	"N": NonScheduled,
+	"G": General,
+	"M": Military,
+	"X": Other,
+}
+
+// A levelUnit is the FIXM equivalent of a unit of flight level
+type levelUnit struct {
+	uom      string
+	altitude bool // altitude is true if the level is an altitude
+	factor   int  // factor converts the ADEXP value to the FIXM one
+}
+
+// levelUnits are the FIXM equivalents of the units of flight levels
+var levelUnits = map[adexp.FlightLevelUnit]levelUnit{
+	adexp.FlightLevelFeet:   {"FL", false, 1},
+	adexp.FlightLevelMetres: {"SM", false, 1},
+	adexp.AltitudeFeet:      {"FT", true, 100},
+	adexp.AltitudeMetres:    {"M", true, 10},
+}
+
+// speedUnits are the FIXM units of measurement of the speeds
+var speedUnits = map[adexp.SpeedUnit]string{
+	adexp.Knots:             "KNOTS",
+	adexp.KilometresPerHour: "KILOMETRES_PER_HOUR",
+	adexp.Mach:              "MACH",
+}
+
+// datalink are the datalink communication capabilities, the other communication ones being in communication
+var (
+	datalink = []adexp.Capability{
+		adexp.CPDLCATNVDL2, adexp.CPDLCFANSHFDL, adexp.CPDLCFANSVDLA, adexp.CPDLCFANSVDL2,
+		adexp.CPDLCFANSSATCOMINMARSAT, adexp.CPDLCFANSSATCOMMTSAT, adexp.CPDLCFANSSATCOMIridium,
+	}
+	communication = []adexp.Capability{
+		adexp.ACARSFMCWPR, adexp.ACARSDFIS, adexp.ACARSPDC, adexp.HFRTF, adexp.RTFSATCOMINMARSAT, adexp.RTFMTSAT, adexp.RTFIridium,
+		adexp.RCP1, adexp.RCP2, adexp.RCP3, adexp.RCP4, adexp.RCP5, adexp.RCP6, adexp.RCP7, adexp.RCP8, adexp.RCP9,
+		adexp.UHFRTF, adexp.VHFRTF, adexp.VHF833,
+	}
+)
+
+// durationRegexp matches the XML durations of the total estimated elapsed time
+var durationRegexp = regexp.MustCompile(`^P(?:([0-9]+)D)?T(?:([0-9]+)H)?(?:([0-9]+)M)?$`)
+
+const (
+	dateLayout = "060102"
+	timeLayout = "1504"
+)
+
+// FromADEXP converts an IFPL or ICHG message to a FIXM flight.
+//
+// The fields of an ICHG message give the flight as amended, the previous values (ARCIDOLD...) are ignored.
+func FromADEXP(msg adexp.ADEXP) (*Flight, error) {
+	title, _ := msg.GetPrimary("TITLE")
+	switch title {
+	case "IFPL", "ICHG":
+	default:
+		return nil, errors.Errorf("FromADEXP: unsupported title %q, expected IFPL or ICHG", title)
+	}
+	get := func(k string) string {
+		v, _ := msg.GetPrimary(k)
+		return v
+	}
+
+	arcid := get("ARCID")
+	if arcid == "" {
+		return nil, errors.New("FromADEXP: no ARCID")
+	}
+	f := &Flight{
+		FlightIdentification: &FlightIdentification{AircraftIdentification: arcid},
+		FlightRulesCategory:  get("FLTRUL"),
+	}
+	if typ := get("FLTTYP"); typ != "" {
+		ft, ok := flightTypes[typ]
+		if !ok {
+			return nil, errors.Errorf("FromADEXP: unknown FLTTYP %q", typ)
+		}
+		f.FlightType = ft
+	}
+
+	var err error
+	if f.AircraftDescription, err = aircraftFromADEXP(msg, get); err != nil {
+		return nil, errors.Wrap(err, "FromADEXP")
+	}
+
+	// Departure
+	if adep := get("ADEP"); adep != "" {
+		f.Departure = &Departure{Aerodrome: &Aerodrome{LocationIndicator: adep}}
+		if get("EOBD") != "" && get("EOBT") != "" {
+			eobt, err := msg.GetDateTime("EOBD", "EOBT")
+			if err != nil {
+				return nil, errors.Wrap(err, "FromADEXP")
+			}
+			f.Departure.EstimatedOffBlockTime = &eobt
+		}
+	}
+
+	// Arrival
+	if ades := get("ADES"); ades != "" {
+		f.Arrival = &Arrival{DestinationAerodrome: &Aerodrome{LocationIndicator: ades}}
+		for _, k := range []string{"ALTRNT1", "ALTRNT2"} {
+			if altn := get(k); altn != "" {
+				f.Arrival.DestinationAerodromeAlternate = append(f.Arrival.DestinationAerodromeAlternate, Aerodrome{LocationIndicator: altn})
+			}
+		}
+	}
+
+	ri, err := routeFromADEXP(msg, get)
+	if err != nil {
+		return nil, errors.Wrap(err, "FromADEXP")
+	}
+	if ri != nil {
+		f.RouteTrajectoryGroup = &RouteTrajectoryGroup{Filed: &RouteTrajectory{RouteInformation: ri}}
+	}
+	return f, nil
+}
+
+// aircraftFromADEXP returns the description of the aircraft, nil if there is none
+func aircraftFromADEXP(msg adexp.ADEXP, get func(string) string) (*Aircraft, error) {
+	if get("ARCTYP") == "" && get("WKTRC") == "" && get("NBARC") == "" && get("REG") == "" && get("CEQPT") == "" {
+		return nil, nil
+	}
+
+	a := &Aircraft{Registration: get("REG"), WakeTurbulence: get("WKTRC")}
+	if nb := get("NBARC"); nb != "" {
+		n, err := strconv.Atoi(nb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid NBARC %q", nb)
+		}
+		a.AircraftQuantity = n
+	}
+	switch typ := get("ARCTYP"); typ {
+	case "":
+	case "ZZZZ":
+		a.AircraftType = &AircraftType{Other: get("TYPZ")}
+	default:
+		a.AircraftType = &AircraftType{ICAODesignator: typ}
+	}
+
+	if get("CEQPT") == "" {
+		return a, nil
+	}
+	cs, err := msg.GetCapabilities()
+	if err != nil {
+		return nil, err
+	}
+	a.Capabilities = &Capabilities{}
+	if codes := designators(cs, communication); codes != "" {
+		a.Capabilities.Communication = &CommunicationCapabilities{Codes: codes}
+	}
+	if codes := designators(cs, datalink); codes != "" {
+		if a.Capabilities.Communication == nil {
+			a.Capabilities.Communication = &CommunicationCapabilities{}
+		}
+		a.Capabilities.Communication.DatalinkCodes = codes
+	}
+
+	// The other aid capabilities are for navigation
+	aid, _ := adexp.ParseCapabilities(cs.CEQPT(), "N")
+	aid.Remove(communication...)
+	aid.Remove(datalink...)
+	if codes := designators(aid, aid.List()); codes != "" {
+		a.Capabilities.Navigation = &NavigationCapabilities{Codes: codes}
+	}
+
+	sur, _ := adexp.ParseCapabilities("N", cs.SEQPT())
+	if codes := designators(sur, sur.List()); codes != "" {
+		a.Capabilities.Surveillance = &SurveillanceCapabilities{Codes: codes}
+	}
+	return a, nil
+}
+
+// designators returns the space-separated designators of the capabilities in cs among the given ones
+func designators(cs adexp.Capabilities, among []adexp.Capability) string {
+	var list []string
+	for _, c := range among {
+		if cs.Has(c) {
+			list = append(list, c.String())
+		}
+	}
+	return strings.Join(list, " ")
+}
+
+// routeFromADEXP returns the route information, nil if there is none
+func routeFromADEXP(msg adexp.ADEXP, get func(string) string) (*RouteInformation, error) {
+	if get("RFL") == "" && get("SPEED") == "" && get("ROUTE") == "" && get("TTLEET") == "" {
+		return nil, nil
+	}
+
+	ri := &RouteInformation{RouteText: get("ROUTE")}
+	if get("RFL") != "" {
+		fl, err := msg.GetFlightLevel("RFL")
+		if err != nil {
+			return nil, err
+		}
+		lu := levelUnits[fl.Unit]
+		m := &Measure{UOM: lu.uom, Value: strconv.Itoa(fl.Value * lu.factor)}
+		if lu.altitude {
+			ri.CruisingLevel = &Level{Altitude: m}
+		} else {
+			ri.CruisingLevel = &Level{FlightLevel: m}
+		}
+	}
+	if get("SPEED") != "" {
+		spd, err := msg.GetSpeed("SPEED")
+		if err != nil {
+			return nil, err
+		}
+		ri.CruisingSpeed = &Measure{UOM: speedUnits[spd.Unit], Value: strconv.Itoa(spd.Value)}
+		if mach, ok := spd.Mach(); ok {
+			ri.CruisingSpeed.Value = strconv.FormatFloat(mach, 'f', 2, 64)
+		}
+	}
+	if eet := get("TTLEET"); eet != "" {
+		hours, err1 := strconv.Atoi(eet[:len(eet)/2])
+		minutes, err2 := strconv.Atoi(eet[len(eet)/2:])
+		if len(eet) != 4 || err1 != nil || err2 != nil {
+			return nil, errors.Errorf("invalid TTLEET %q, expected HHMM", eet)
+		}
+		ri.TotalEstimatedElapsedTime = fmt.Sprintf("PT%dH%dM", hours, minutes)
+	}
+	return ri, nil
+}
+
+// ToADEXP converts a FIXM flight to an IFPL message, for the fields provided by FromADEXP.
+//
+// The equipment is given in its canonical form, see adexp.Capabilities.
+func ToADEXP(f *Flight) (adexp.ADEXP, error) {
+	kv := map[string]string{
+		"TITLE":  "IFPL",
+		"FLTRUL": f.FlightRulesCategory,
+	}
+	if f.FlightIdentification != nil {
+		kv["ARCID"] = f.FlightIdentification.AircraftIdentification
+	}
+	if kv["ARCID"] == "" {
+		return nil, errors.New("ToADEXP: no aircraft identification")
+	}
+	if f.FlightType != "" {
+		for typ, ft := range flightTypes {
+			if ft == f.FlightType {
+				kv["FLTTYP"] = typ
+			}
+		}
+		if kv["FLTTYP"] == "" {
+			return nil, errors.Errorf("ToADEXP: unknown type of flight %q", f.FlightType)
+		}
+	}
+
+	if err := aircraftToADEXP(f.AircraftDescription, kv); err != nil {
+		return nil, errors.Wrap(err, "ToADEXP")
+	}
+
+	if d := f.Departure; d != nil {
+		if d.Aerodrome != nil {
+			kv["ADEP"] = d.Aerodrome.LocationIndicator
+		}
+		if d.EstimatedOffBlockTime != nil {
+			eobt := d.EstimatedOffBlockTime.UTC()
+			kv["EOBD"], kv["EOBT"] = eobt.Format(dateLayout), eobt.Format(timeLayout)
+		}
+	}
+
+	if a := f.Arrival; a != nil {
+		if a.DestinationAerodrome != nil {
+			kv["ADES"] = a.DestinationAerodrome.LocationIndicator
+		}
+		if len(a.DestinationAerodromeAlternate) > 2 {
+			return nil, errors.Errorf("ToADEXP: %d alternate aerodromes, at most 2 are supported", len(a.DestinationAerodromeAlternate))
+		}
+		for i, altn := range a.DestinationAerodromeAlternate {
+			kv["ALTRNT"+strconv.Itoa(i+1)] = altn.LocationIndicator
+		}
+	}
+
+	if rtg := f.RouteTrajectoryGroup; rtg != nil && rtg.Filed != nil && rtg.Filed.RouteInformation != nil {
+		if err := routeToADEXP(rtg.Filed.RouteInformation, kv); err != nil {
+			return nil, errors.Wrap(err, "ToADEXP")
+		}
+	}
+
+	msg := adexp.ADEXP{}
+	for k, v := range kv {
+		if v == "" {
+			continue
+		}
+		if err := msg.SetPrimary(k, v); err != nil {
+			return nil, errors.Wrap(err, "ToADEXP")
+		}
+	}
+	return msg, nil
+}
+
+// aircraftToADEXP sets the fields describing the aircraft
+func aircraftToADEXP(a *Aircraft, kv map[string]string) error {
+	if a == nil {
+		return nil
+	}
+	kv["REG"], kv["WKTRC"] = a.Registration, a.WakeTurbulence
+	if a.AircraftQuantity > 1 {
+		kv["NBARC"] = strconv.Itoa(a.AircraftQuantity)
+	}
+	if t := a.AircraftType; t != nil {
+		kv["ARCTYP"] = t.ICAODesignator
+		if t.ICAODesignator == "" && t.Other != "" {
+			kv["ARCTYP"], kv["TYPZ"] = "ZZZZ", t.Other
+		}
+	}
+
+	c := a.Capabilities
+	if c == nil {
+		return nil
+	}
+	var aid, sur []string
+	if c.Communication != nil {
+		aid = append(aid, strings.Fields(c.Communication.Codes)...)
+		aid = append(aid, strings.Fields(c.Communication.DatalinkCodes)...)
+	}
+	if c.Navigation != nil {
+		aid = append(aid, strings.Fields(c.Navigation.Codes)...)
+	}
+	if c.Surveillance != nil {
+		sur = strings.Fields(c.Surveillance.Codes)
+	}
+	ceqpt, seqpt := strings.Join(aid, ""), strings.Join(sur, "")
+	if ceqpt == "" {
+		ceqpt = "N"
+	}
+	if seqpt == "" {
+		seqpt = "N"
+	}
+	cs, err := adexp.ParseCapabilities(ceqpt, seqpt)
+	if err != nil {
+		return err
+	}
+	kv["CEQPT"], kv["SEQPT"] = cs.CEQPT(), cs.SEQPT()
+	return nil
+}
+
+// routeToADEXP sets the fields describing the route
+func routeToADEXP(ri *RouteInformation, kv map[string]string) error {
+	kv["ROUTE"] = ri.RouteText
+
+	if l := ri.CruisingLevel; l != nil {
+		m := l.FlightLevel
+		if m == nil {
+			m = l.Altitude
+		}
+		if m == nil {
+			return errors.New("cruising level without flight level nor altitude")
+		}
+		found := false
+		for unit, lu := range levelUnits {
+			if lu.uom != m.UOM || lu.altitude != (m == l.Altitude) {
+				continue
+			}
+			val, err := strconv.Atoi(strings.TrimSpace(m.Value))
+			if err != nil || val%lu.factor != 0 {
+				return errors.Errorf("invalid cruising level %q %s", m.Value, m.UOM)
+			}
+			kv["RFL"], found = adexp.FlightLevel{Unit: unit, Value: val / lu.factor}.String(), true
+		}
+		if !found {
+			return errors.Errorf("unsupported unit of cruising level %q", m.UOM)
+		}
+	}
+
+	if m := ri.CruisingSpeed; m != nil {
+		found := false
+		for unit, uom := range speedUnits {
+			if uom != m.UOM {
+				continue
+			}
+			val, err := strconv.ParseFloat(strings.TrimSpace(m.Value), 64)
+			if err != nil {
+				return errors.Errorf("invalid cruising speed %q %s", m.Value, m.UOM)
+			}
+			if unit == adexp.Mach {
+				val *= 100
+			}
+			kv["SPEED"], found = adexp.Speed{Unit: unit, Value: int(val + 0.5)}.String(), true
+		}
+		if !found {
+			return errors.Errorf("unsupported unit of cruising speed %q", m.UOM)
+		}
+	}
+
+	if eet := ri.TotalEstimatedElapsedTime; eet != "" {
+		m := durationRegexp.FindStringSubmatch(eet)
+		if m == nil {
+			return errors.Errorf("unsupported total estimated elapsed time %q", eet)
+		}
+		var d time.Duration
+		for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute} {
+			n, _ := strconv.Atoi(m[i+1])
+			d += time.Duration(n) * unit
+		}
+		kv["TTLEET"] = fmt.Sprintf("%02d%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return nil
+}
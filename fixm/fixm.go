@@ -0,0 +1,130 @@
+/*
+Package fixm provides the FIXM (Flight Information Exchange Model) representation of flights, and their conversion to and from ADEXP.
+
+Only the subset of FIXM Core 4.2 with an equivalent in the ADEXP flight plan messages is provided:
+the aircraft identification, the departure & destination aerodromes, the estimated off-block time, the route and the aircraft description with its equipment.
+Flights are encoded and decoded with encoding/xml.
+*/
+package fixm
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// These are the namespaces of FIXM Core 4.2
+const (
+	FlightNamespace = "http://www.fixm.aero/flight/4.2"
+	BaseNamespace   = "http://www.fixm.aero/base/4.2"
+)
+
+// A Flight is a FIXM Flight document
+type Flight struct {
+	XMLName              xml.Name              `xml:"http://www.fixm.aero/flight/4.2 Flight"`
+	AircraftDescription  *Aircraft             `xml:"aircraftDescription,omitempty"`
+	Arrival              *Arrival              `xml:"arrival,omitempty"`
+	Departure            *Departure            `xml:"departure,omitempty"`
+	FlightIdentification *FlightIdentification `xml:"flightIdentification,omitempty"`
+	FlightRulesCategory  string                `xml:"flightRulesCategory,omitempty"` // FlightRulesCategory is I, V, Y or Z
+	FlightType           string                `xml:"flightType,omitempty"`          // FlightType is Scheduled, NonScheduled, General, Military or Other
+	RouteTrajectoryGroup *RouteTrajectoryGroup `xml:"routeTrajectoryGroup,omitempty"`
+}
+
+// These are the types of flight
+const (
+	Scheduled    = "SCHEDULED"
+	NonScheduled = "NON_SCHEDULED"
+	General      = "GENERAL"
+	Military     = "MILITARY"
+	Other        = "OTHER"
+)
+
+// An Aircraft describes the aircraft of a flight
+type Aircraft struct {
+	AircraftQuantity int           `xml:"aircraftQuantity,omitempty"`
+	AircraftType     *AircraftType `xml:"aircraftType,omitempty"`
+	Capabilities     *Capabilities `xml:"capabilities,omitempty"`
+	Registration     string        `xml:"registration,omitempty"`
+	WakeTurbulence   string        `xml:"wakeTurbulence,omitempty"` // WakeTurbulence is L, M, H or J
+}
+
+// An AircraftType is the type of an aircraft, either as an ICAO designator or as free text
+type AircraftType struct {
+	ICAODesignator string `xml:"icaoAircraftTypeDesignator,omitempty"`
+	Other          string `xml:"otherAircraftType,omitempty"`
+}
+
+// Capabilities are the equipment & capabilities of an aircraft, as space-separated lists of ICAO designators
+type Capabilities struct {
+	Communication *CommunicationCapabilities `xml:"communication,omitempty"`
+	Navigation    *NavigationCapabilities    `xml:"navigation,omitempty"`
+	Surveillance  *SurveillanceCapabilities  `xml:"surveillance,omitempty"`
+}
+
+// CommunicationCapabilities are the communication capabilities, such as "H V Y", and the datalink ones, such as "J1"
+type CommunicationCapabilities struct {
+	Codes         string `xml:"communicationCode,omitempty"`
+	DatalinkCodes string `xml:"datalinkCommunicationCode,omitempty"`
+}
+
+// NavigationCapabilities are the navigation capabilities, such as "D G I L O"
+type NavigationCapabilities struct {
+	Codes string `xml:"navigationCode,omitempty"`
+}
+
+// SurveillanceCapabilities are the surveillance capabilities, such as "L B1"
+type SurveillanceCapabilities struct {
+	Codes string `xml:"surveillanceCode,omitempty"`
+}
+
+// An Aerodrome references an aerodrome by its ICAO location indicator
+type Aerodrome struct {
+	LocationIndicator string `xml:"http://www.fixm.aero/base/4.2 locationIndicator"`
+}
+
+// Arrival holds the destination of a flight
+type Arrival struct {
+	DestinationAerodrome          *Aerodrome  `xml:"destinationAerodrome,omitempty"`
+	DestinationAerodromeAlternate []Aerodrome `xml:"destinationAerodromeAlternate,omitempty"`
+}
+
+// Departure holds the departure of a flight
+type Departure struct {
+	Aerodrome             *Aerodrome `xml:"aerodrome,omitempty"`
+	EstimatedOffBlockTime *time.Time `xml:"estimatedOffBlockTime,omitempty"`
+}
+
+// FlightIdentification identifies a flight
+type FlightIdentification struct {
+	AircraftIdentification string `xml:"aircraftIdentification"`
+}
+
+// A RouteTrajectoryGroup holds the routes of a flight, only the filed one being provided
+type RouteTrajectoryGroup struct {
+	Filed *RouteTrajectory `xml:"filed,omitempty"`
+}
+
+// A RouteTrajectory is a route of a flight
+type RouteTrajectory struct {
+	RouteInformation *RouteInformation `xml:"routeInformation,omitempty"`
+}
+
+// RouteInformation describes a route as filed in a flight plan
+type RouteInformation struct {
+	CruisingLevel             *Level   `xml:"cruisingLevel,omitempty"`
+	CruisingSpeed             *Measure `xml:"cruisingSpeed,omitempty"`
+	RouteText                 string   `xml:"routeText,omitempty"`                 // RouteText is the route as in ICAO field 15
+	TotalEstimatedElapsedTime string   `xml:"totalEstimatedElapsedTime,omitempty"` // TotalEstimatedElapsedTime is an XML duration, such as PT1H30M
+}
+
+// A Level is either a flight level or an altitude
+type Level struct {
+	FlightLevel *Measure `xml:"flightLevel,omitempty"` // FlightLevel is in FL (hundreds of feet) or SM (tens of metres)
+	Altitude    *Measure `xml:"altitude,omitempty"`    // Altitude is in FT or M
+}
+
+// A Measure is a value with its unit of measurement
+type Measure struct {
+	UOM   string `xml:"uom,attr"`
+	Value string `xml:",chardata"`
+}
@@ -0,0 +1,116 @@
+package fixm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aabizri/aero/adexp"
+)
+
+// testIFPL is the message exported in testdata/ifpl.xml
+const testIFPL = `-TITLE IFPL -ARCID AFR456 -FLTRUL I -FLTTYP S -ARCTYP A320 -WKTRC M -REG FGKXA
+-CEQPT SDE2FGHIJ1RWY -SEQPT LB1 -ADEP LFPG -EOBD 140110 -EOBT 0900
+-SPEED N0450 -RFL F350 -ROUTE N0450F350 DCT XETBO UN869 BUBLI
+-ADES EGLL -TTLEET 0130 -ALTRNT1 EGKK`
+
+// testMessage returns the message exported in testdata/ifpl.xml
+func testMessage(t *testing.T) adexp.ADEXP {
+	return decode(t, testIFPL)
+}
+
+// decode decodes an ADEXP message
+func decode(t *testing.T, text string) adexp.ADEXP {
+	msg := adexp.ADEXP{}
+	if err := adexp.NewDecoder(strings.NewReader(text)).Decode(msg); err != nil {
+		t.Fatalf("error while decoding: %v", err)
+	}
+	return msg
+}
+
+// fixture reads a file of testdata
+func fixture(t *testing.T, name string) []byte {
+	b, err := ioutil.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("error while reading fixture: %v", err)
+	}
+	return b
+}
+
+func TestFromADEXP(t *testing.T) {
+	tests := []struct {
+		msg     adexp.ADEXP
+		fixture string
+	}{
+		{testMessage(t), "ifpl.xml"},
+		{decode(t, "-TITLE ICHG -ARCID AFR457 -ARCIDOLD AFR456 -ADEP LFPG -ADES EGLL -EOBD 140110 -EOBT 0930 -RFL A045 -SPEED M082 -CEQPT N"), "ichg.xml"},
+	}
+	for _, test := range tests {
+		f, err := FromADEXP(test.msg)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.fixture, err)
+			continue
+		}
+		out, err := xml.MarshalIndent(f, "", "  ")
+		if err != nil {
+			t.Errorf("%s: error while marshalling: %v", test.fixture, err)
+			continue
+		}
+		expected := bytes.TrimSpace(fixture(t, test.fixture))
+		if got := append([]byte(xml.Header), out...); !bytes.Equal(got, expected) {
+			t.Errorf("%s: unexpected XML:\n%s\nexpected:\n%s", test.fixture, got, expected)
+		}
+	}
+
+	for _, text := range []string{"-TITLE IARR -ARCID AFR456", "-TITLE IFPL -ADEP LFPG", "-TITLE IFPL -ARCID AFR456 -FLTTYP Q", "-TITLE IFPL -ARCID AFR456 -RFL 350"} {
+		if _, err := FromADEXP(decode(t, text)); err == nil {
+			t.Errorf("%q: expected an error", text)
+		}
+	}
+}
+
+func TestToADEXP(t *testing.T) {
+	// The export of an IFPL round-trips
+	f := &Flight{}
+	if err := xml.Unmarshal(fixture(t, "ifpl.xml"), f); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	msg, err := ToADEXP(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := testMessage(t)
+	if !reflect.DeepEqual(msg, expected) {
+		got, _ := msg.MarshalText()
+		want, _ := expected.MarshalText()
+		t.Errorf("unexpected message:\n%s\nexpected:\n%s", got, want)
+	}
+}
+
+func TestToADEXP_Prefixed(t *testing.T) {
+	// A document written by another system, with namespace prefixes & an altitude in metres
+	f := &Flight{}
+	if err := xml.Unmarshal(fixture(t, "flight.xml"), f); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if f.Departure == nil || f.Departure.EstimatedOffBlockTime == nil || !f.Departure.EstimatedOffBlockTime.Equal(time.Date(2014, 1, 10, 23, 45, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected departure: %#v", f.Departure)
+	}
+	msg, err := ToADEXP(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for k, v := range map[string]string{
+		"TITLE": "IFPL", "ARCID": "DLH4AB", "FLTRUL": "I", "FLTTYP": "N", "ARCTYP": "ZZZZ", "TYPZ": "GLIDER", "NBARC": "2",
+		"CEQPT": "SG", "SEQPT": "C", "ADEP": "EDDF", "EOBD": "140110", "EOBT": "2345", "ADES": "LFPO",
+		"RFL": "M0840", "SPEED": "K0830", "TTLEET": "2610",
+	} {
+		if got, ok := msg.GetPrimary(k); !ok || got != v {
+			t.Errorf("%s: expected %q, got (%q, %t)", k, v, got, ok)
+		}
+	}
+}
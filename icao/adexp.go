@@ -0,0 +1,505 @@
+package icao
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aabizri/aero/adexp"
+	"github.com/pkg/errors"
+)
+
+// A mapping converts a field to and from its ADEXP fields.
+// The fields are held by keyword, the DEPZ, DESTZ & ALTNZ compound fields being held by the name of their aerodrome, see aerodromeNames.
+type mapping struct {
+	keywords []string
+	to       func(msg *Message, kv map[string]string) error
+	from     func(get func(string) string, msg *Message) error
+}
+
+// mappings are the ADEXP equivalents of the fields, following the ADEXP specification
+var mappings = map[int]mapping{
+	7: {
+		keywords: []string{"ARCID", "SSRCODE"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["ARCID"] = msg.ARCID
+			if msg.SSRMode != "" {
+				kv["SSRCODE"] = msg.SSRMode + msg.SSRCode
+			}
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.ARCID = get("ARCID")
+			msg.SSRMode, msg.SSRCode = "", ""
+			if code := get("SSRCODE"); len(code) == 5 {
+				msg.SSRMode, msg.SSRCode = code[:1], code[1:]
+			}
+			return nil
+		},
+	},
+	8: {
+		keywords: []string{"FLTRUL", "FLTTYP"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["FLTRUL"], kv["FLTTYP"] = msg.FlightRules, msg.FlightType
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.FlightRules, msg.FlightType = get("FLTRUL"), get("FLTTYP")
+			return nil
+		},
+	},
+	9: {
+		keywords: []string{"NBARC", "ARCTYP", "WKTRC"},
+		to: func(msg *Message, kv map[string]string) error {
+			if msg.NumberOfAircraft > 1 {
+				kv["NBARC"] = strconv.Itoa(msg.NumberOfAircraft)
+			}
+			kv["ARCTYP"], kv["WKTRC"] = msg.AircraftType, msg.WakeTurbulence
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.NumberOfAircraft = 0
+			if nb := get("NBARC"); nb != "" {
+				n, err := strconv.Atoi(nb)
+				if err != nil {
+					return errors.Wrapf(err, "invalid NBARC %q", nb)
+				}
+				msg.NumberOfAircraft = n
+			}
+			msg.AircraftType, msg.WakeTurbulence = get("ARCTYP"), get("WKTRC")
+			return nil
+		},
+	},
+	10: {
+		keywords: []string{"CEQPT", "SEQPT"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["CEQPT"], kv["SEQPT"] = msg.Equipment, msg.Surveillance
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.Equipment, msg.Surveillance = get("CEQPT"), get("SEQPT")
+			return nil
+		},
+	},
+	13: {
+		keywords: []string{"ADEP", "EOBT", "ATD"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["ADEP"] = msg.Departure
+			kv[departureTimeKeyword(msg.Type)] = msg.DepartureTime
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.Departure, msg.DepartureTime = get("ADEP"), get(departureTimeKeyword(msg.Type))
+			return nil
+		},
+	},
+	15: {
+		keywords: []string{"SPEED", "RFL", "ROUTE"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["SPEED"], kv["RFL"], kv["ROUTE"] = msg.Speed, msg.Level, msg.formatField(15)
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			// ROUTE holds the whole field
+			if route := get("ROUTE"); route != "" {
+				return msg.parseField(15, route)
+			}
+			msg.Speed, msg.Level, msg.Route = get("SPEED"), get("RFL"), ""
+			return nil
+		},
+	},
+	16: {
+		keywords: []string{"ADES", "TTLEET", "ALTRNT1", "ALTRNT2"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["ADES"], kv["TTLEET"] = msg.Destination, msg.TotalEET
+			for i, altn := range msg.Alternates {
+				kv["ALTRNT"+strconv.Itoa(i+1)] = altn
+			}
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.Destination, msg.TotalEET, msg.Alternates = get("ADES"), get("TTLEET"), nil
+			for _, k := range []string{"ALTRNT1", "ALTRNT2"} {
+				if altn := get(k); altn != "" {
+					msg.Alternates = append(msg.Alternates, altn)
+				}
+			}
+			return nil
+		},
+	},
+	17: {
+		keywords: []string{"ADARR", "ATA", "ADARRZ"},
+		to: func(msg *Message, kv map[string]string) error {
+			kv["ADARR"], kv["ATA"], kv["ADARRZ"] = msg.Arrival, msg.ArrivalTime, msg.ArrivalName
+			return nil
+		},
+		from: func(get func(string) string, msg *Message) error {
+			msg.Arrival, msg.ArrivalTime, msg.ArrivalName = get("ADARR"), get("ATA"), get("ADARRZ")
+			return nil
+		},
+	},
+	18: {
+		keywords: otherKeywords(),
+		to:       otherToADEXP,
+		from:     otherFromADEXP,
+	},
+	19: {
+		keywords: []string{"SPLE", "SPLP", "SPLR", "SPLS", "SPLJ", "SPLDNB", "SPLDCAP", "SPLDCOV", "SPLDCOL", "SPLA", "SPLN", "SPLC"},
+		to:       supplementaryToADEXP,
+		from:     supplementaryFromADEXP,
+	},
+}
+
+// departureTimeKeyword returns the keyword of the time of field 13, which is the actual time of departure in DEP & ARR messages
+func departureTimeKeyword(typ string) string {
+	if typ == "DEP" || typ == "ARR" {
+		return "ATD"
+	}
+	return "EOBT"
+}
+
+// supplementaryRegexp matches the indicators of field 19
+var supplementaryRegexp = regexp.MustCompile(`(?:^| )([EPRSJDANC])/`)
+
+// supplementaryKeywords are the ADEXP equivalents of the simple indicators of field 19, in order
+var supplementaryKeywords = []struct {
+	indicator string
+	keyword   string
+}{
+	{"E", "SPLE"}, {"P", "SPLP"}, {"R", "SPLR"}, {"S", "SPLS"}, {"J", "SPLJ"}, {"D", ""}, {"A", "SPLA"}, {"N", "SPLN"}, {"C", "SPLC"},
+}
+
+func supplementaryToADEXP(msg *Message, kv map[string]string) error {
+	for _, ind := range splitIndicators(msg.Supplementary, supplementaryRegexp) {
+		if ind[0] != "D" {
+			for _, sk := range supplementaryKeywords {
+				if sk.indicator == ind[0] {
+					kv[sk.keyword] = ind[1]
+				}
+			}
+			continue
+		}
+
+		// Dinghies: number, capacity, C if covered, colour
+		parts := strings.Fields(ind[1])
+		if len(parts) > 0 {
+			kv["SPLDNB"], parts = parts[0], parts[1:]
+		}
+		if len(parts) > 0 {
+			kv["SPLDCAP"], parts = parts[0], parts[1:]
+		}
+		kv["SPLDCOV"] = "F"
+		if len(parts) > 0 && parts[0] == "C" {
+			kv["SPLDCOV"], parts = "T", parts[1:]
+		}
+		kv["SPLDCOL"] = strings.Join(parts, " ")
+	}
+	return nil
+}
+
+func supplementaryFromADEXP(get func(string) string, msg *Message) error {
+	var parts []string
+	for _, sk := range supplementaryKeywords {
+		if sk.indicator == "D" {
+			if nb := get("SPLDNB"); nb != "" {
+				dinghies := []string{nb, get("SPLDCAP")}
+				if get("SPLDCOV") == "T" {
+					dinghies = append(dinghies, "C")
+				}
+				dinghies = append(dinghies, get("SPLDCOL"))
+				parts = append(parts, "D/"+strings.Join(strings.Fields(strings.Join(dinghies, " ")), " "))
+			}
+			continue
+		}
+		if val := get(sk.keyword); val != "" {
+			parts = append(parts, sk.indicator+"/"+val)
+		}
+	}
+	msg.Supplementary = strings.Join(parts, " ")
+	return nil
+}
+
+// otherKeywords returns the keywords of the ADEXP equivalents of the field 18 indicators, EET being given by EETFIR & EETPT
+func otherKeywords() []string {
+	keywords := []string{"EETFIR"}
+	for _, name := range Indicators {
+		if k, ok := (Indicator{Name: name}).Keyword(); ok {
+			keywords = append(keywords, k)
+		}
+	}
+	return keywords
+}
+
+// aerodromeNames are the compound fields given by the name of an aerodrome in their ADNAME subfield, for the DEP, DEST & ALTN indicators
+var aerodromeNames = map[string]bool{"DEPZ": true, "DESTZ": true, "ALTNZ": true}
+
+// eetRegexp matches an element of the EET indicator, a FIR or a point followed by the elapsed time to it, such as EGTT0010
+var eetRegexp = regexp.MustCompile(`^([A-Z0-9]{2,5})([0-9]{4})$`)
+
+// firRegexp matches a FIR designator
+var firRegexp = regexp.MustCompile(`^[A-Z]{4}$`)
+
+// otherToADEXP converts the field 18 indicators to their ADEXP equivalents.
+//
+// As a message only holds one field per keyword, the indicators which would need several fields of a keyword return an error:
+// repeated indicators, several statuses in STS, and more than one FIR or point in EET, whose elements are given by EETFIR & EETPT.
+// The indicators without an ADEXP equivalent, such as DLE, return an error too.
+func otherToADEXP(msg *Message, kv map[string]string) error {
+	seen := make(map[string]bool, len(msg.Other))
+	for _, ind := range msg.Other {
+		if seen[ind.Name] {
+			return errors.Errorf("indicator %s is repeated, a message only holds one of its ADEXP equivalent", ind.Name)
+		}
+		seen[ind.Name] = true
+
+		switch ind.Name {
+		case "EET":
+			if err := eetToADEXP(ind.Content, kv); err != nil {
+				return err
+			}
+			continue
+		case "STS":
+			if len(strings.Fields(ind.Content)) > 1 {
+				return errors.Errorf("indicator STS has several statuses, a message only holds one STS field")
+			}
+		}
+
+		k, ok := ind.Keyword()
+		if !ok {
+			return errors.Errorf("indicator %s has no ADEXP equivalent", ind.Name)
+		}
+		kv[k] = ind.Content
+	}
+	return nil
+}
+
+// eetToADEXP converts the content of the EET indicator to EETFIR & EETPT fields
+func eetToADEXP(content string, kv map[string]string) error {
+	for _, elem := range strings.Fields(content) {
+		m := eetRegexp.FindStringSubmatch(elem)
+		if m == nil {
+			return errors.Errorf("EET element %q isn't a FIR or a named point followed by an elapsed time", elem)
+		}
+		k := "EETPT"
+		if firRegexp.MatchString(m[1]) {
+			k = "EETFIR"
+		}
+		if _, ok := kv[k]; ok {
+			return errors.Errorf("EET element %q: a message only holds one %s field", elem, k)
+		}
+		kv[k] = m[1] + " " + m[2]
+	}
+	return nil
+}
+
+// otherFromADEXP converts the ADEXP equivalents of the field 18 indicators, in the order of Indicators.
+// EET is made of the FIR of EETFIR followed by the point of EETPT.
+func otherFromADEXP(get func(string) string, msg *Message) error {
+	msg.Other = nil
+	for _, name := range Indicators {
+		ind := Indicator{Name: name}
+		if name == "EET" {
+			var elems []string
+			for _, k := range []string{"EETFIR", "EETPT"} {
+				if v := get(k); v != "" {
+					elems = append(elems, strings.Replace(v, " ", "", -1))
+				}
+			}
+			ind.Content = strings.Join(elems, " ")
+		} else if k, ok := ind.Keyword(); ok {
+			ind.Content = get(k)
+		}
+		if ind.Content != "" {
+			msg.Other = append(msg.Other, ind)
+		}
+	}
+	return nil
+}
+
+// splitIndicators splits a field made of indicators, such as "E/0745 P/3", in pairs of indicator & content.
+// re must match the indicators, with a leading separator, and capture their name.
+func splitIndicators(content string, re *regexp.Regexp) [][2]string {
+	locs := re.FindAllStringSubmatchIndex(content, -1)
+	pairs := make([][2]string, len(locs))
+	for i, loc := range locs {
+		end := len(content)
+		if i+1 < len(locs) {
+			end = locs[i+1][0]
+		}
+		pairs[i] = [2]string{content[loc[2]:loc[3]], strings.TrimSpace(content[loc[1]:end])}
+	}
+	return pairs
+}
+
+// identification are the keywords identifying a flight in a CHG message, whose previous value is kept with the OLD suffix when amended
+var identification = map[string]bool{"ARCID": true, "ADEP": true, "EOBD": true, "EOBT": true, "ADES": true}
+
+// ToADEXP converts the message to ADEXP, its title being the type prefixed by I, such as IFPL.
+//
+// The amendments of a CHG message are converted to the new values of the fields, the previous values of the fields identifying the flight being kept in ARCIDOLD, ADEPOLD, EOBDOLD, EOBTOLD and ADESOLD.
+// The date of flight, given by DOF in field 18, is converted to EOBD, and the field 18 indicators which can't be held by the message return an error, see otherToADEXP.
+func ToADEXP(msg *Message) (adexp.ADEXP, error) {
+	format, ok := formats[msg.Type]
+	if !ok {
+		return nil, errors.Errorf("ToADEXP: unsupported message type %q", msg.Type)
+	}
+
+	kv := make(map[string]string)
+	for _, s := range format {
+		if s.field == 22 {
+			continue
+		}
+		if err := mappings[s.field].to(msg, kv); err != nil {
+			return nil, errors.Wrapf(err, "ToADEXP: field %d", s.field)
+		}
+	}
+
+	// Amendments
+	amended := &Message{Type: msg.Type}
+	for _, a := range msg.Amendments {
+		if _, ok := mappings[a.Field]; !ok {
+			return nil, errors.Errorf("ToADEXP: unsupported amendment of field %d", a.Field)
+		}
+		if err := amended.parseField(a.Field, a.Content); err != nil {
+			return nil, errors.Wrapf(err, "ToADEXP: amendment of field %d", a.Field)
+		}
+		newKV := make(map[string]string)
+		if err := mappings[a.Field].to(amended, newKV); err != nil {
+			return nil, errors.Wrapf(err, "ToADEXP: amendment of field %d", a.Field)
+		}
+		// The amendment replaces the whole field
+		for _, k := range mappings[a.Field].keywords {
+			if _, ok := newKV[k]; !ok && !identification[k] {
+				delete(kv, k)
+			}
+		}
+		for k, v := range newKV {
+			if old := kv[k]; identification[k] && old != "" && old != v {
+				kv[k+"OLD"] = old
+			}
+			kv[k] = v
+		}
+	}
+
+	a := adexp.ADEXP{}
+	if err := a.SetPrimary("TITLE", "I"+msg.Type); err != nil {
+		return nil, errors.Wrap(err, "ToADEXP")
+	}
+	for k, v := range kv {
+		if v == "" {
+			continue
+		}
+		if err := setField(a, k, v); err != nil {
+			return nil, errors.Wrap(err, "ToADEXP")
+		}
+	}
+	return a, nil
+}
+
+// setField sets the field of the given keyword, see mapping
+func setField(a adexp.ADEXP, k string, v string) error {
+	if !aerodromeNames[k] {
+		return a.SetPrimary(k, v)
+	}
+	sub := adexp.NewStructured()
+	if err := sub.SetPrimary("ADNAME", v); err != nil {
+		return errors.Wrapf(err, "field %s", k)
+	}
+	return a.AddStructured(k, sub)
+}
+
+// getField returns the field of the given keyword, empty if absent, see mapping.
+// The compound fields without ADNAME are given by their point, PTID.
+func getField(a adexp.ADEXP, k string) string {
+	if !aerodromeNames[k] {
+		v, _ := a.GetPrimary(k)
+		return v
+	}
+	sub, ok := a.GetStructured(k)
+	if !ok {
+		return ""
+	}
+	if v, ok := sub.GetPrimary("ADNAME"); ok {
+		return v
+	}
+	v, _ := sub.GetPrimary("PTID")
+	return v
+}
+
+// FromADEXP converts an ADEXP message to ICAO, its title being the type, possibly prefixed by I, such as IFPL.
+//
+// In a CHG message, the fields with an ADEXP equivalent are converted to amendments, see ToADEXP.
+func FromADEXP(a adexp.ADEXP) (*Message, error) {
+	title, ok := a.GetPrimary("TITLE")
+	if !ok {
+		return nil, errors.New("FromADEXP: no TITLE")
+	}
+	msg := &Message{Type: title}
+	if _, ok := formats[title]; !ok {
+		msg.Type = strings.TrimPrefix(title, "I")
+	}
+	format, ok := formats[msg.Type]
+	if !ok {
+		return nil, errors.Errorf("FromADEXP: unsupported title %s", title)
+	}
+
+	get := func(k string) string {
+		return getField(a, k)
+	}
+
+	// In a CHG message, the identification fields take their previous values
+	base := get
+	if msg.Type == "CHG" {
+		base = func(k string) string {
+			if old := get(k + "OLD"); identification[k] && old != "" {
+				return old
+			}
+			return get(k)
+		}
+	}
+
+	for _, s := range format {
+		if s.field == 22 {
+			continue
+		}
+		if err := mappings[s.field].from(base, msg); err != nil {
+			return nil, errors.Wrapf(err, "FromADEXP: field %d", s.field)
+		}
+	}
+	if msg.Type != "FPL" && msg.Type != "ARR" {
+		// Only the destination is given in field 16
+		msg.TotalEET, msg.Alternates = "", nil
+	}
+	if msg.Type != "CHG" {
+		return msg, nil
+	}
+
+	// A new SSR code is an amendment of field 7
+	msg.SSRMode, msg.SSRCode = "", ""
+
+	// Field 18 only identifies the flight by its date, the other indicators are amendments
+	msg.Other = nil
+	if dof := base("EOBD"); dof != "" {
+		msg.Other = OtherInformation{{"DOF", dof}}
+	}
+
+	// Amendments
+	amended := &Message{Type: msg.Type}
+	for _, field := range []int{7, 8, 9, 10, 13, 15, 16, 18, 19} {
+		m := mappings[field]
+		amend := false
+		for _, k := range m.keywords {
+			if (identification[k] && get(k+"OLD") != "") || (!identification[k] && get(k) != "") {
+				amend = true
+			}
+		}
+		if !amend {
+			continue
+		}
+		if err := m.from(get, amended); err != nil {
+			return nil, errors.Wrapf(err, "FromADEXP: amendment of field %d", field)
+		}
+		msg.Amendments = append(msg.Amendments, Amendment{Field: field, Content: amended.formatField(field)})
+	}
+	return msg, nil
+}
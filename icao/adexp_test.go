@@ -0,0 +1,189 @@
+package icao
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aabizri/aero/adexp"
+)
+
+func TestToADEXP(t *testing.T) {
+	msg, err := Parse(testFPL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a, err := ToADEXP(msg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for k, v := range map[string]string{
+		"TITLE": "IFPL", "ARCID": "AFR456", "FLTRUL": "I", "FLTTYP": "S", "ARCTYP": "A320", "WKTRC": "M",
+		"CEQPT": "SDE2FGHIRWY", "SEQPT": "LB1", "ADEP": "LFPG", "EOBT": "0900",
+		"SPEED": "N0450", "RFL": "F350", "ROUTE": "N0450F350 DCT XETBO UN869 BUBLI/N0440F370 DCT",
+		"ADES": "EGLL", "TTLEET": "0100", "ALTRNT1": "EGKK", "PBN": "A1B1", "EOBD": "140110", "REG": "FGKXA", "RMK": "TCAS",
+		"SPLE": "0745", "SPLP": "TBN", "SPLDNB": "2", "SPLDCAP": "8", "SPLDCOV": "T", "SPLDCOL": "YELLOW", "SPLA": "WHITE BLUE", "SPLC": "DUPONT",
+	} {
+		if got, ok := a.GetPrimary(k); !ok || got != v {
+			t.Errorf("%s: expected %q, got (%q, %t)", k, v, got, ok)
+		}
+	}
+
+	back, err := FromADEXP(a)
+	if err != nil {
+		t.Fatalf("unexpected error while converting back: %v", err)
+	}
+	if !reflect.DeepEqual(back, msg) {
+		t.Errorf("unexpected message converted back:\n%#v\nexpected:\n%#v", back, msg)
+	}
+}
+
+func TestToADEXP_Validate(t *testing.T) {
+	for _, text := range []string{
+		strings.Replace(testFPL, "P/TBN", "P/3", 1),
+		"(FPL-AFR456-IS-A320/M-SDE2FGHIRWY/LB1-ZZZZ0900-N0450F350 DCT XETBO UN869 BUBLI/N0440F370 DCT-ZZZZ0100 ZZZZ" +
+			"-STS/HOSP PBN/A1B1 DEP/MY FIELD DEST/MYFIELD DOF/140110 EET/EGTT0010 XETBO0045 ALTN/OTHER FIELD RMK/TCAS)",
+	} {
+		msg, err := Parse(text)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		a, err := ToADEXP(msg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The IFPLID is given by the IFPS
+		if err := a.SetPrimary("IFPLID", "AA12345678"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := adexp.Validate(a); err != nil {
+			t.Errorf("%s: invalid ADEXP message: %v", msg.ARCID, err)
+		}
+		delete(a, "IFPLID")
+
+		back, err := FromADEXP(a)
+		if err != nil {
+			t.Fatalf("unexpected error while converting back: %v", err)
+		}
+		if !reflect.DeepEqual(back, msg) {
+			t.Errorf("unexpected message converted back:\n%#v\nexpected:\n%#v", back, msg)
+		}
+	}
+}
+
+func TestToADEXP_Other(t *testing.T) {
+	msg, err := Parse("(FPL-AFR456-IS-A320/M-SDE2FGHIRWY/LB1-ZZZZ0900-N0450F350 DCT-EGLL0100" +
+		"-DEST/MYFIELD DEP/MY FIELD EET/EGTT0010 XETBO0045)")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a, err := ToADEXP(msg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for k, v := range map[string]string{"DEPZ": "MY FIELD", "DESTZ": "MYFIELD"} {
+		sub, ok := a.GetStructured(k)
+		if !ok {
+			t.Errorf("%s isn't a structured field", k)
+			continue
+		}
+		if name, _ := sub.GetPrimary("ADNAME"); name != v {
+			t.Errorf("%s: expected ADNAME %q, got %q", k, v, name)
+		}
+	}
+	for k, v := range map[string]string{"EETFIR": "EGTT 0010", "EETPT": "XETBO 0045"} {
+		if got, ok := a.GetPrimary(k); !ok || got != v {
+			t.Errorf("%s: expected %q, got (%q, %t)", k, v, got, ok)
+		}
+	}
+
+	// The indicators which can't be held by a message
+	for _, other := range []string{
+		"PBN/A1B1 DOF/140110 DEST/MYFIELD EET/EGTT0010 EISN0045 DLE/XETBO0010 RMK/TCAS",
+		"DLE/XETBO0010",
+		"RMK/TCAS RMK/AGAIN",
+		"EET/EGTT0010 EISN0045",
+		"EET/5230N01530W0010",
+		"STS/HOSP PROTECTED",
+	} {
+		if msg.Other, err = ParseOtherInformation(other); err != nil {
+			t.Fatalf("%q: unexpected error: %v", other, err)
+		}
+		if _, err := ToADEXP(msg); err == nil {
+			t.Errorf("%q: expected an error", other)
+		}
+	}
+}
+
+func TestToADEXP_CHG(t *testing.T) {
+	msg, err := Parse("(CHG-AFR456-LFPG0900-EGLL-7/AFR457/A1234-8/IS-16/EGKK0110 EGLL)")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a, err := ToADEXP(msg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for k, v := range map[string]string{
+		"TITLE": "ICHG", "ARCID": "AFR457", "ARCIDOLD": "AFR456", "SSRCODE": "A1234", "FLTRUL": "I",
+		"ADEP": "LFPG", "EOBT": "0900", "ADES": "EGKK", "ADESOLD": "EGLL", "TTLEET": "0110", "ALTRNT1": "EGLL",
+	} {
+		if got, ok := a.GetPrimary(k); !ok || got != v {
+			t.Errorf("%s: expected %q, got (%q, %t)", k, v, got, ok)
+		}
+	}
+	if _, ok := a.GetPrimary("ADEPOLD"); ok {
+		t.Errorf("ADEP wasn't amended, there should be no ADEPOLD")
+	}
+
+	back, err := FromADEXP(a)
+	if err != nil {
+		t.Fatalf("unexpected error while converting back: %v", err)
+	}
+	if !reflect.DeepEqual(back, msg) {
+		t.Errorf("unexpected message converted back:\n%#v\nexpected:\n%#v", back, msg)
+	}
+}
+
+func TestToADEXP_CHGOther(t *testing.T) {
+	msg, err := Parse("(CHG-AFR456-LFPG0900-EGLL-DOF/140110 RMK/TCAS-18/DOF/140111 REG/FGKXA)")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a, err := ToADEXP(msg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for k, v := range map[string]string{"EOBD": "140111", "EOBDOLD": "140110", "REG": "FGKXA"} {
+		if got, ok := a.GetPrimary(k); !ok || got != v {
+			t.Errorf("%s: expected %q, got (%q, %t)", k, v, got, ok)
+		}
+	}
+	if _, ok := a.GetPrimary("RMK"); ok {
+		t.Errorf("RMK should have been removed by the amendment of field 18")
+	}
+
+	back, err := FromADEXP(a)
+	if err != nil {
+		t.Fatalf("unexpected error while converting back: %v", err)
+	}
+	msg.Other = OtherInformation{{"DOF", "140110"}}
+	if !reflect.DeepEqual(back, msg) {
+		t.Errorf("unexpected message converted back:\n%#v\nexpected:\n%#v", back, msg)
+	}
+}
+
+func TestFromADEXP_Errors(t *testing.T) {
+	a := adexp.ADEXP{}
+	if _, err := FromADEXP(a); err == nil {
+		t.Errorf("expected an error without title")
+	}
+	a.SetPrimary("TITLE", "SAM")
+	if _, err := FromADEXP(a); err == nil {
+		t.Errorf("expected an error for an unsupported title")
+	}
+}
@@ -0,0 +1,131 @@
+package icao
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// fieldRegexps are the syntaxes of the fields with a fixed structure
+var fieldRegexps = map[int]*regexp.Regexp{
+	3:  regexp.MustCompile(`^([A-Z]{3})(?:/([A-Z]{1,4}[0-9]{3})([A-Z]{1,4}[0-9]{3})?)?$`),
+	7:  regexp.MustCompile(`^([A-Z0-9]{2,7})(?:/([A-Z])([0-7]{4}))?$`),
+	8:  regexp.MustCompile(`^([IVYZ])([SNGMX])?$`),
+	9:  regexp.MustCompile(`^([0-9]{1,2})?([A-Z][A-Z0-9]{1,3})/([LMHJ])$`),
+	10: regexp.MustCompile(`^([A-Z0-9]+)/([A-Z0-9]+)$`),
+	13: regexp.MustCompile(`^([A-Z]{4})([0-9]{4})?$`),
+	15: regexp.MustCompile(`^([NK][0-9]{4}|M[0-9]{3})([FA][0-9]{3}|[SM][0-9]{4}|VFR)(?: (.*))?$`),
+	16: regexp.MustCompile(`^([A-Z]{4})([0-9]{4})?((?: [A-Z]{4}){0,2})$`),
+	17: regexp.MustCompile(`^([A-Z]{4})([0-9]{4})(?: (.*))?$`),
+}
+
+// parseField parses the content of a field into the message
+func (msg *Message) parseField(field int, content string) error {
+	var m []string
+	if re, ok := fieldRegexps[field]; ok {
+		m = re.FindStringSubmatch(content)
+		if m == nil {
+			return errors.Errorf("invalid content %q", content)
+		}
+	}
+
+	switch field {
+	case 3:
+		msg.Type, msg.Number, msg.Reference = m[1], m[2], m[3]
+	case 7:
+		msg.ARCID, msg.SSRMode, msg.SSRCode = m[1], m[2], m[3]
+	case 8:
+		msg.FlightRules, msg.FlightType = m[1], m[2]
+	case 9:
+		msg.NumberOfAircraft = 0
+		if m[1] != "" {
+			msg.NumberOfAircraft, _ = strconv.Atoi(m[1])
+		}
+		msg.AircraftType, msg.WakeTurbulence = m[2], m[3]
+	case 10:
+		msg.Equipment, msg.Surveillance = m[1], m[2]
+	case 13:
+		msg.Departure, msg.DepartureTime = m[1], m[2]
+	case 15:
+		msg.Speed, msg.Level, msg.Route = m[1], m[2], m[3]
+	case 16:
+		msg.Destination, msg.TotalEET, msg.Alternates = m[1], m[2], nil
+		if m[3] != "" {
+			msg.Alternates = strings.Fields(m[3])
+		}
+	case 17:
+		msg.Arrival, msg.ArrivalTime, msg.ArrivalName = m[1], m[2], m[3]
+	case 18:
+		other, err := ParseOtherInformation(content)
+		if err != nil {
+			return err
+		}
+		msg.Other = other
+	case 19:
+		msg.Supplementary = content
+	default:
+		return errors.Errorf("unsupported field %d", field)
+	}
+	return nil
+}
+
+// formatField returns the content of a field of the message, or an empty string if there is none
+func (msg *Message) formatField(field int) string {
+	switch field {
+	case 3:
+		return msg.Type + joinNonEmpty("/", msg.Number+msg.Reference)
+	case 7:
+		if msg.ARCID == "" {
+			return ""
+		}
+		return msg.ARCID + joinNonEmpty("/", msg.SSRMode+msg.SSRCode)
+	case 8:
+		return msg.FlightRules + msg.FlightType
+	case 9:
+		if msg.AircraftType == "" {
+			return ""
+		}
+		var number string
+		if msg.NumberOfAircraft > 1 {
+			number = strconv.Itoa(msg.NumberOfAircraft)
+		}
+		return number + msg.AircraftType + "/" + msg.WakeTurbulence
+	case 10:
+		if msg.Equipment == "" {
+			return ""
+		}
+		return msg.Equipment + "/" + msg.Surveillance
+	case 13:
+		return msg.Departure + msg.DepartureTime
+	case 15:
+		if msg.Speed == "" {
+			return ""
+		}
+		return msg.Speed + msg.Level + joinNonEmpty(" ", msg.Route)
+	case 16:
+		if msg.Destination == "" {
+			return ""
+		}
+		return strings.Join(append([]string{msg.Destination + msg.TotalEET}, msg.Alternates...), " ")
+	case 17:
+		if msg.Arrival == "" {
+			return ""
+		}
+		return msg.Arrival + msg.ArrivalTime + joinNonEmpty(" ", msg.ArrivalName)
+	case 18:
+		return msg.Other.String()
+	case 19:
+		return msg.Supplementary
+	}
+	return ""
+}
+
+// joinNonEmpty returns sep followed by str, or an empty string if str is empty
+func joinNonEmpty(sep string, str string) string {
+	if str == "" {
+		return ""
+	}
+	return sep + str
+}
@@ -0,0 +1,236 @@
+/*
+Package icao provides parsing and formatting of ICAO ATS messages, as defined by ICAO Doc 4444 Appendix 3, and their conversion to and from ADEXP.
+
+The supported messages are FPL, CHG, CNL, DLA, DEP and ARR, with fields 3, 7, 8, 9, 10, 13, 15, 16, 17, 18, 19 and 22.
+*/
+package icao
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A Message is an ICAO ATS message, such as (FPL-AFR456-IS-A320/M-SDE2FGHIRWY/LB1-LFPG0900-N0450F350 DCT XETBO-EGLL0100-0)
+//
+// Only the fields of the message's type are meaningful.
+type Message struct {
+	// Field 3: message type, number & reference data
+	Type      string
+	Number    string
+	Reference string
+
+	// Field 7: aircraft identification & SSR mode and code
+	ARCID   string
+	SSRMode string
+	SSRCode string
+
+	// Field 8: flight rules & type of flight
+	FlightRules string
+	FlightType  string
+
+	// Field 9: number & type of aircraft, wake turbulence category
+	NumberOfAircraft int // NumberOfAircraft is 0 when not given, meaning a single aircraft
+	AircraftType     string
+	WakeTurbulence   string
+
+	// Field 10: equipment & capabilities
+	Equipment    string
+	Surveillance string
+
+	// Field 13: departure aerodrome & time
+	Departure     string
+	DepartureTime string
+
+	// Field 15: cruising speed, cruising level & route
+	Speed string
+	Level string
+	Route string
+
+	// Field 16: destination aerodrome, total estimated elapsed time & alternate aerodromes
+	Destination string
+	TotalEET    string
+	Alternates  []string
+
+	// Field 17: arrival aerodrome & time
+	Arrival     string
+	ArrivalTime string
+	ArrivalName string
+
+	// Field 18: other information, empty when "0"
+	Other OtherInformation
+
+	// Field 19: supplementary information
+	Supplementary string
+
+	// Field 22: amendments, in a CHG message
+	Amendments []Amendment
+}
+
+// An Amendment is a field 22 element, giving the new content of a field
+type Amendment struct {
+	Field   int
+	Content string
+}
+
+// String returns the amendment as written in field 22, such as 8/IS
+func (a Amendment) String() string {
+	return strconv.Itoa(a.Field) + "/" + a.Content
+}
+
+// A slot is a field of a message format
+type slot struct {
+	field    int
+	optional bool
+}
+
+// formats are the fields following field 3 in each type of message
+var formats = map[string][]slot{
+	"FPL": {{7, false}, {8, false}, {9, false}, {10, false}, {13, false}, {15, false}, {16, false}, {18, false}, {19, true}},
+	"CHG": {{7, false}, {13, false}, {16, false}, {18, true}, {22, false}},
+	"CNL": {{7, false}, {13, false}, {16, false}, {18, true}},
+	"DLA": {{7, false}, {13, false}, {16, false}, {18, true}},
+	"DEP": {{7, false}, {13, false}, {16, false}, {18, true}},
+	"ARR": {{7, false}, {13, false}, {16, true}, {17, false}},
+}
+
+// amendmentRegexp matches a field 22 element
+var amendmentRegexp = regexp.MustCompile(`^([0-9]{1,2})/(.*)$`)
+
+// Parse parses an ICAO ATS message.
+// Line breaks and repeated spaces are allowed, they are normalised to a single space inside the fields.
+func Parse(text string) (*Message, error) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "(") || !strings.HasSuffix(text, ")") {
+		return nil, errors.New("Parse: a message must be enclosed in parentheses")
+	}
+	parts := strings.Split(text[1:len(text)-1], "-")
+	for i, p := range parts {
+		parts[i] = strings.Join(strings.Fields(p), " ")
+	}
+
+	msg := &Message{}
+	if err := msg.parseField(3, parts[0]); err != nil {
+		return nil, errors.Wrap(err, "Parse: field 3")
+	}
+	format, ok := formats[msg.Type]
+	if !ok {
+		return nil, errors.Errorf("Parse: unsupported message type %s", msg.Type)
+	}
+
+	parts = parts[1:]
+	for i, s := range format {
+		// Field 22 takes all the remaining parts
+		if s.field == 22 {
+			for _, p := range parts {
+				m := amendmentRegexp.FindStringSubmatch(p)
+				if m == nil {
+					return nil, errors.Errorf("Parse: invalid field 22 %q", p)
+				}
+				n, _ := strconv.Atoi(m[1])
+				msg.Amendments = append(msg.Amendments, Amendment{Field: n, Content: m[2]})
+			}
+			parts = nil
+			break
+		}
+
+		if len(parts) == 0 {
+			if s.optional {
+				continue
+			}
+			return nil, errors.Errorf("Parse: missing field %d", s.field)
+		}
+
+		// An optional field is skipped if the parts left are needed for the mandatory fields, or if it is an amendment
+		if s.optional && (len(parts) <= mandatoryAfter(format[i+1:]) || amendmentRegexp.MatchString(parts[0])) {
+			continue
+		}
+
+		if err := msg.parseField(s.field, parts[0]); err != nil {
+			return nil, errors.Wrapf(err, "Parse: field %d", s.field)
+		}
+		parts = parts[1:]
+	}
+
+	if len(parts) != 0 {
+		return nil, errors.Errorf("Parse: %d unexpected field(s) at the end of a %s message", len(parts), msg.Type)
+	}
+	return msg, nil
+}
+
+// mandatoryAfter returns the number of mandatory fields in the given slots, field 22 counting as one
+func mandatoryAfter(slots []slot) int {
+	n := 0
+	for _, s := range slots {
+		if !s.optional {
+			n++
+		}
+	}
+	return n
+}
+
+// String returns the message in ICAO format, with the usual line breaks
+func (msg *Message) String() string {
+	text, err := msg.MarshalText()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	return string(text)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (msg *Message) MarshalText() ([]byte, error) {
+	format, ok := formats[msg.Type]
+	if !ok {
+		return nil, errors.Errorf("MarshalText: unsupported message type %q", msg.Type)
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString("(" + msg.formatField(3))
+	for _, s := range format {
+		if s.field == 22 {
+			if len(msg.Amendments) == 0 {
+				return nil, errors.New("MarshalText: a CHG message needs at least one amendment")
+			}
+			for _, a := range msg.Amendments {
+				b.WriteString("\n-" + a.String())
+			}
+			continue
+		}
+
+		content := msg.formatField(s.field)
+		if content == "" || (s.optional && s.field == 18 && content == "0") {
+			if s.optional {
+				continue
+			}
+			return nil, errors.Errorf("MarshalText: field %d is empty", s.field)
+		}
+		if strings.ContainsRune(content, '-') {
+			return nil, errors.Errorf("MarshalText: field %d %q contains a hyphen", s.field, content)
+		}
+
+		// Fields 7, 8 & 10 stay on the line of the preceding field
+		switch s.field {
+		case 7, 8, 10:
+			b.WriteString("-")
+		default:
+			b.WriteString("\n-")
+		}
+		b.WriteString(content)
+	}
+	b.WriteString(")")
+	return b.Bytes(), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (msg *Message) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return errors.Wrap(err, "UnmarshalText")
+	}
+	*msg = *parsed
+	return nil
+}
@@ -0,0 +1,103 @@
+package icao
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testFPL = `(FPL-AFR456-IS
+-A320/M-SDE2FGHIRWY/LB1
+-LFPG0900
+-N0450F350 DCT XETBO UN869 BUBLI/N0440F370 DCT
+-EGLL0100 EGKK
+-PBN/A1B1 DOF/140110 REG/FGKXA RMK/TCAS
+-E/0745 P/TBN R/VE S/M J/L D/2 8 C YELLOW A/WHITE BLUE C/DUPONT)`
+
+func TestParse(t *testing.T) {
+	msg, err := Parse(testFPL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &Message{
+		Type:  "FPL",
+		ARCID: "AFR456", FlightRules: "I", FlightType: "S",
+		AircraftType: "A320", WakeTurbulence: "M", Equipment: "SDE2FGHIRWY", Surveillance: "LB1",
+		Departure: "LFPG", DepartureTime: "0900",
+		Speed: "N0450", Level: "F350", Route: "DCT XETBO UN869 BUBLI/N0440F370 DCT",
+		Destination: "EGLL", TotalEET: "0100", Alternates: []string{"EGKK"},
+		Other:         OtherInformation{{"PBN", "A1B1"}, {"DOF", "140110"}, {"REG", "FGKXA"}, {"RMK", "TCAS"}},
+		Supplementary: "E/0745 P/TBN R/VE S/M J/L D/2 8 C YELLOW A/WHITE BLUE C/DUPONT",
+	}
+	if !reflect.DeepEqual(msg, expected) {
+		t.Errorf("unexpected message:\n%#v\nexpected:\n%#v", msg, expected)
+	}
+
+	// Formatting it back gives the same text
+	if msg.String() != testFPL {
+		t.Errorf("unexpected formatting:\n%s\nexpected:\n%s", msg, testFPL)
+	}
+}
+
+func TestParse_Types(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected *Message
+	}{
+		{
+			"(DLA-AFR456/A1234-LFPG0930-EGLL-0)",
+			&Message{Type: "DLA", ARCID: "AFR456", SSRMode: "A", SSRCode: "1234", Departure: "LFPG", DepartureTime: "0930", Destination: "EGLL"},
+		},
+		{
+			"(CNL-AFR456-LFPG0900-EGLL-DOF/140110)",
+			&Message{Type: "CNL", ARCID: "AFR456", Departure: "LFPG", DepartureTime: "0900", Destination: "EGLL", Other: OtherInformation{{"DOF", "140110"}}},
+		},
+		{
+			"(DEP-AFR456-LFPG0912-EGLL)",
+			&Message{Type: "DEP", ARCID: "AFR456", Departure: "LFPG", DepartureTime: "0912", Destination: "EGLL"},
+		},
+		{
+			"(ARR-AFR456-LFPG-EGLL1005)",
+			&Message{Type: "ARR", ARCID: "AFR456", Departure: "LFPG", Arrival: "EGLL", ArrivalTime: "1005"},
+		},
+		{
+			"(ARR-AFR456-LFPG-EGLL-EGKK1015)",
+			&Message{Type: "ARR", ARCID: "AFR456", Departure: "LFPG", Destination: "EGLL", Arrival: "EGKK", ArrivalTime: "1015"},
+		},
+		{
+			"(CHG/A052A049-AFR456-LFPG0900-EGLL-8/IS-15/N0450F370 DCT XETBO DCT)",
+			&Message{Type: "CHG", Number: "A052", Reference: "A049", ARCID: "AFR456", Departure: "LFPG", DepartureTime: "0900", Destination: "EGLL",
+				Amendments: []Amendment{{8, "IS"}, {15, "N0450F370 DCT XETBO DCT"}}},
+		},
+	}
+
+	for _, test := range tests {
+		msg, err := Parse(test.text)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.text, err)
+			continue
+		}
+		if !reflect.DeepEqual(msg, test.expected) {
+			t.Errorf("%s: unexpected message:\n%#v", test.text, msg)
+		}
+		if _, err := msg.MarshalText(); err != nil {
+			t.Errorf("%s: error while formatting back: %v", test.text, err)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, text := range []string{
+		"FPL-AFR456-IS",
+		"(XXX-AFR456-IS)",
+		"(FPL-AFR456-IS-A320/M-SDE2FGHIRWY/LB1-LFPG0900)",
+		"(DLA-AFR456-LFPG0930-EGLL-0-0)",
+		"(DEP-AFR-456-LFPG0912-EGLL)",
+		"(CHG-AFR456-LFPG0900-EGLL-IS)",
+		"(FPL-AFR456-IS-A320-SDE2FGHIRWY/LB1-LFPG0900-N0450F350 DCT-EGLL0100-0)",
+	} {
+		if _, err := Parse(text); err == nil {
+			t.Errorf("%s: expected an error", text)
+		}
+	}
+}
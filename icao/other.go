@@ -0,0 +1,81 @@
+package icao
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aabizri/aero/adexp/catalog"
+	"github.com/pkg/errors"
+)
+
+// Indicators are the indicators of field 18, in the order of ICAO Doc 4444, followed by the ones used by the IFPS
+var Indicators = []string{
+	"STS", "PBN", "NAV", "COM", "DAT", "SUR", "DEP", "DEST", "DOF", "REG", "EET", "SEL", "TYP", "CODE", "DLE",
+	"OPR", "ORGN", "PER", "ALTN", "RALT", "TALT", "RIF", "EUR", "RFP", "IFP", "RMK",
+}
+
+// indicatorRegexp matches the indicators of field 18
+var indicatorRegexp = regexp.MustCompile(`(?:^| )(` + strings.Join(Indicators, "|") + `)/`)
+
+// An Indicator is an element of field 18, such as DOF/140110
+type Indicator struct {
+	Name    string
+	Content string
+}
+
+// String returns the indicator as written in field 18
+func (ind Indicator) String() string {
+	return ind.Name + "/" + ind.Content
+}
+
+// Keyword returns the keyword of the equivalent ADEXP field, such as EOBD for DOF or DEPZ for DEP, see catalog.LookupIndicator
+func (ind Indicator) Keyword() (string, bool) {
+	return catalog.LookupIndicator(ind.Name)
+}
+
+// OtherInformation is the content of field 18, as a list of indicators in the order of the message
+type OtherInformation []Indicator
+
+// ParseOtherInformation parses the content of field 18, such as "PBN/A1B1 DOF/140110 REG/FGKXA RMK/TCAS".
+// A content of "0" means there is no indicator.
+func ParseOtherInformation(content string) (OtherInformation, error) {
+	content = strings.Join(strings.Fields(content), " ")
+	if content == "" || content == "0" {
+		return nil, nil
+	}
+
+	pairs := splitIndicators(content, indicatorRegexp)
+	if len(pairs) == 0 || !strings.HasPrefix(content, pairs[0][0]+"/") {
+		return nil, errors.Errorf("ParseOtherInformation: %q doesn't start with an indicator", content)
+	}
+	other := make(OtherInformation, len(pairs))
+	for i, p := range pairs {
+		if p[1] == "" {
+			return nil, errors.Errorf("ParseOtherInformation: indicator %s is empty", p[0])
+		}
+		other[i] = Indicator{Name: p[0], Content: p[1]}
+	}
+	return other, nil
+}
+
+// Get returns the content of the first indicator of the given name
+func (other OtherInformation) Get(name string) (string, bool) {
+	for _, ind := range other {
+		if ind.Name == name {
+			return ind.Content, true
+		}
+	}
+	return "", false
+}
+
+// String returns the content of field 18, "0" if there is no indicator
+func (other OtherInformation) String() string {
+	if len(other) == 0 {
+		return "0"
+	}
+	parts := make([]string, len(other))
+	for i, ind := range other {
+		parts[i] = ind.String()
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,53 @@
+package icao
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseOtherInformation(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected OtherInformation
+	}{
+		{"0", nil},
+		{"PBN/A1B1 DOF/140110 REG/FGKXA RMK/TCAS", OtherInformation{{"PBN", "A1B1"}, {"DOF", "140110"}, {"REG", "FGKXA"}, {"RMK", "TCAS"}}},
+		{"STS/HOSP  RMK/A/C WITH TCAS II", OtherInformation{{"STS", "HOSP"}, {"RMK", "A/C WITH TCAS II"}}},
+		{"EET/LFFF0020 EGTT0045 RMK/X RMK/Y", OtherInformation{{"EET", "LFFF0020 EGTT0045"}, {"RMK", "X"}, {"RMK", "Y"}}},
+	}
+	for _, test := range tests {
+		other, err := ParseOtherInformation(test.content)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.content, err)
+			continue
+		}
+		if !reflect.DeepEqual(other, test.expected) {
+			t.Errorf("%q: got %v, expected %v", test.content, other, test.expected)
+		}
+	}
+
+	for _, content := range []string{"TCAS", "XYZ/1", "RMK/TCAS DOF/", "TCAS DOF/140110"} {
+		if _, err := ParseOtherInformation(content); err == nil {
+			t.Errorf("%q: expected an error", content)
+		}
+	}
+}
+
+func TestOtherInformation(t *testing.T) {
+	other := OtherInformation{{"PBN", "A1B1"}, {"DOF", "140110"}, {"XYZ", "1"}}
+	if s := other.String(); s != "PBN/A1B1 DOF/140110 XYZ/1" {
+		t.Errorf("unexpected string %q", s)
+	}
+	if s := OtherInformation(nil).String(); s != "0" {
+		t.Errorf("unexpected string %q for no indicator", s)
+	}
+	if dof, ok := other.Get("DOF"); !ok || dof != "140110" {
+		t.Errorf("unexpected DOF: (%q, %t)", dof, ok)
+	}
+
+	for i, expected := range []string{"PBN", "EOBD", ""} {
+		if k, _ := other[i].Keyword(); k != expected {
+			t.Errorf("%s: got keyword %q, expected %q", other[i].Name, k, expected)
+		}
+	}
+}
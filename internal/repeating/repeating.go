@@ -37,3 +37,13 @@ func (sr *StringReader) ReadRune() (rune, int, error) {
 	}
 	return r, s, err
 }
+
+// Read reads from a StringReader, starting over at the end of the string
+func (sr *StringReader) Read(b []byte) (int, error) {
+	n, err := sr.Reader.Read(b)
+	if err == io.EOF {
+		sr.Reader.Seek(0, io.SeekStart)
+		n, err = sr.Reader.Read(b)
+	}
+	return n, err
+}